@@ -0,0 +1,94 @@
+package standards
+
+import (
+	"github.com/unpackdev/standards/shared"
+)
+
+// AccountRole represents the role a contract plays within account abstraction (ERC-4337 and ERC-7579).
+type AccountRole string
+
+// String returns the string representation of the AccountRole.
+func (r AccountRole) String() string {
+	return string(r)
+}
+
+const (
+	AccountRoleNone       AccountRole = ""           // Contract plays no account abstraction role.
+	AccountRoleEntryPoint AccountRole = "entrypoint" // ERC-4337 EntryPoint.
+	AccountRolePaymaster  AccountRole = "paymaster"  // ERC-4337 Paymaster.
+	AccountRoleAggregator AccountRole = "aggregator" // ERC-4337 Signature Aggregator.
+	AccountRoleModule     AccountRole = "module"     // ERC-7579 Module, e.g. validator, executor or hook.
+	AccountRoleAccount    AccountRole = "account"    // ERC-4337 or ERC-7579 smart account.
+)
+
+// accountRoles maps account abstraction standards to the role of contracts implementing them.
+var accountRoles = map[shared.Standard]AccountRole{
+	ERC4337ENTRYPOINT: AccountRoleEntryPoint,
+	ERC4337PAYMASTER:  AccountRolePaymaster,
+	ERC4337AGGREGATOR: AccountRoleAggregator,
+	ERC7579MODULE:     AccountRoleModule,
+	ERC7579VALIDATOR:  AccountRoleModule,
+	ERC7579HOOK:       AccountRoleModule,
+	ERC4337ACCOUNT:    AccountRoleAccount,
+	ERC7579ACCOUNT:    AccountRoleAccount,
+}
+
+// accountRolePriority breaks ties between roles matched at the same confidence. Accounts come last, as
+// validator modules and paymasters expose validation functions closely resembling IAccount.
+var accountRolePriority = map[AccountRole]int{
+	AccountRoleEntryPoint: 5,
+	AccountRolePaymaster:  4,
+	AccountRoleAggregator: 3,
+	AccountRoleModule:     2,
+	AccountRoleAccount:    1,
+}
+
+// GetAccountRole returns the account abstraction role of contracts implementing the standard.
+func GetAccountRole(standard shared.Standard) AccountRole {
+	return accountRoles[standard]
+}
+
+// ClassifyAccount returns the account abstraction role of a contract out of its discoveries, as returned by
+// Detector.Detect. Discoveries below medium confidence are ignored. The role of the discovery with the highest
+// confidence wins, followed by confidence points and the role priority when discoveries are tied.
+func ClassifyAccount(discoveries []shared.Discovery) AccountRole {
+	toReturn := AccountRoleNone
+	var best *shared.Discovery
+
+	for i := range discoveries {
+		discovery := &discoveries[i]
+
+		role := GetAccountRole(discovery.Standard)
+		if role == AccountRoleNone || discovery.Confidence < shared.MediumConfidence {
+			continue
+		}
+
+		if best != nil {
+			switch {
+			case discovery.Confidence != best.Confidence:
+				if discovery.Confidence < best.Confidence {
+					continue
+				}
+			case discovery.ConfidencePoints != best.ConfidencePoints:
+				if discovery.ConfidencePoints < best.ConfidencePoints {
+					continue
+				}
+			case accountRolePriority[role] <= accountRolePriority[toReturn]:
+				continue
+			}
+		}
+
+		best, toReturn = discovery, role
+	}
+
+	return toReturn
+}
+
+// ClassifyAccount detects standards of the contract and returns its account abstraction role, see ClassifyAccount.
+func (d *Detector) ClassifyAccount(contract *shared.ContractMatcher) (AccountRole, error) {
+	discoveries, err := d.Detect(contract)
+	if err != nil {
+		return AccountRoleNone, err
+	}
+	return ClassifyAccount(discoveries), nil
+}
@@ -0,0 +1,133 @@
+package standards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// mergeMatchers returns a contract exposing members of all provided contracts.
+func mergeMatchers(name string, contracts ...*shared.ContractMatcher) *shared.ContractMatcher {
+	toReturn := &shared.ContractMatcher{Name: name}
+	for _, contract := range contracts {
+		toReturn.Functions = append(toReturn.Functions, contract.Functions...)
+		toReturn.Events = append(toReturn.Events, contract.Events...)
+		toReturn.Errors = append(toReturn.Errors, contract.Errors...)
+	}
+	return toReturn
+}
+
+func TestClassifyAccount(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		contract *shared.ContractMatcher
+		expected AccountRole
+	}{
+		{name: "EntryPoint", contract: standardMatcher(t, ERC4337ENTRYPOINT), expected: AccountRoleEntryPoint},
+		{name: "Account", contract: standardMatcher(t, ERC4337ACCOUNT), expected: AccountRoleAccount},
+		{name: "Paymaster", contract: standardMatcher(t, ERC4337PAYMASTER), expected: AccountRolePaymaster},
+		{name: "Aggregator", contract: standardMatcher(t, ERC4337AGGREGATOR), expected: AccountRoleAggregator},
+		{name: "Executor", contract: standardMatcher(t, ERC7579MODULE), expected: AccountRoleModule},
+		{name: "Validator", contract: standardMatcher(t, ERC7579VALIDATOR), expected: AccountRoleModule},
+		{name: "Hook", contract: standardMatcher(t, ERC7579HOOK), expected: AccountRoleModule},
+		{
+			name:     "ModularAccount",
+			contract: mergeMatchers("ModularAccount", standardMatcher(t, ERC7579ACCOUNT), standardMatcher(t, ERC4337ACCOUNT), standardMatcher(t, OZOWNABLE)),
+			expected: AccountRoleAccount,
+		},
+		{
+			name:     "OwnablePaymaster",
+			contract: mergeMatchers("OwnablePaymaster", standardMatcher(t, ERC4337PAYMASTER), standardMatcher(t, OZOWNABLE)),
+			expected: AccountRolePaymaster,
+		},
+		{name: "Token", contract: standardMatcher(t, ERC20), expected: AccountRoleNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := detector.ClassifyAccount(tt.contract)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, role)
+		})
+	}
+
+	_, err = detector.ClassifyAccount(nil)
+	assert.ErrorIs(t, err, errors.ErrInvalidContract)
+}
+
+func TestClassifyAccountDiscoveries(t *testing.T) {
+	discoveries := []shared.Discovery{
+		{Standard: ERC4337ACCOUNT, Confidence: shared.PerfectConfidence, ConfidencePoints: 1},
+		{Standard: ERC7579VALIDATOR, Confidence: shared.PerfectConfidence, ConfidencePoints: 1},
+		{Standard: ERC4337PAYMASTER, Confidence: shared.LowConfidence, ConfidencePoints: 0.2},
+	}
+	assert.Equal(t, AccountRoleModule, ClassifyAccount(discoveries))
+	assert.Equal(t, AccountRoleAccount, ClassifyAccount(discoveries[:1]))
+	assert.Equal(t, AccountRoleNone, ClassifyAccount(discoveries[2:]))
+	assert.Equal(t, AccountRoleNone, ClassifyAccount(nil))
+
+	assert.Equal(t, AccountRoleModule, GetAccountRole(ERC7579HOOK))
+	assert.Equal(t, AccountRoleNone, GetAccountRole(ERC20))
+}
+
+// packedUserOperation is the ABI of the ERC-4337 v0.7 PackedUserOperation struct parameter.
+const packedUserOperation = `{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"}`
+
+func TestClassifyAccountReferenceImplementations(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		abi      string
+		expected AccountRole
+	}{
+		{
+			// SimpleAccount of the eth-infinitism v0.7 reference implementation, an upgradeable owned account.
+			name: "SimpleAccount",
+			abi: `[{"inputs":[` + packedUserOperation + `,{"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"internalType":"uint256","name":"missingAccountFunds","type":"uint256"}],"name":"validateUserOp","outputs":[{"internalType":"uint256","name":"validationData","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},` +
+				`{"inputs":[{"internalType":"address","name":"dest","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"func","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"},` +
+				`{"inputs":[{"internalType":"address[]","name":"dest","type":"address[]"},{"internalType":"uint256[]","name":"value","type":"uint256[]"},{"internalType":"bytes[]","name":"func","type":"bytes[]"}],"name":"executeBatch","outputs":[],"stateMutability":"nonpayable","type":"function"},` +
+				`{"inputs":[],"name":"entryPoint","outputs":[{"internalType":"contract IEntryPoint","name":"","type":"address"}],"stateMutability":"view","type":"function"},` +
+				`{"inputs":[],"name":"getNonce","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},` +
+				`{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},` +
+				`{"inputs":[{"internalType":"address","name":"newImplementation","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"upgradeToAndCall","outputs":[],"stateMutability":"payable","type":"function"},` +
+				`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"contract IEntryPoint","name":"entryPoint","type":"address"},{"indexed":true,"internalType":"address","name":"owner","type":"address"}],"name":"SimpleAccountInitialized","type":"event"},` +
+				`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"implementation","type":"address"}],"name":"Upgraded","type":"event"}]`,
+			expected: AccountRoleAccount,
+		},
+		{
+			// VerifyingPaymaster of the eth-infinitism v0.7 reference implementation.
+			name: "VerifyingPaymaster",
+			abi: `[{"inputs":[` + packedUserOperation + `,{"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"internalType":"uint256","name":"maxCost","type":"uint256"}],"name":"validatePaymasterUserOp","outputs":[{"internalType":"bytes","name":"context","type":"bytes"},{"internalType":"uint256","name":"validationData","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},` +
+				`{"inputs":[{"internalType":"enum IPaymaster.PostOpMode","name":"mode","type":"uint8"},{"internalType":"bytes","name":"context","type":"bytes"},{"internalType":"uint256","name":"actualGasCost","type":"uint256"},{"internalType":"uint256","name":"actualUserOpFeePerGas","type":"uint256"}],"name":"postOp","outputs":[],"stateMutability":"nonpayable","type":"function"},` +
+				`{"inputs":[` + packedUserOperation + `,{"internalType":"uint48","name":"validUntil","type":"uint48"},{"internalType":"uint48","name":"validAfter","type":"uint48"}],"name":"getHash","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},` +
+				`{"inputs":[],"name":"verifyingSigner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},` +
+				`{"inputs":[],"name":"deposit","outputs":[],"stateMutability":"payable","type":"function"},` +
+				`{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`,
+			expected: AccountRolePaymaster,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract, err := shared.NewContractMatcherFromABI(tt.name, []byte(tt.abi))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			role, err := detector.ClassifyAccount(contract)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, role)
+		})
+	}
+}
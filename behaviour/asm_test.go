@@ -0,0 +1,182 @@
+package behaviour
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// asm assembles EVM bytecode of the test contracts, as no Solidity compiler is available to the tests.
+type asm struct {
+	code   []byte
+	labels map[string]int
+	fixups map[int]string // Offsets of PUSH2 data to be replaced by label offsets.
+}
+
+// expr emits instructions leaving a single value on the stack, or none for statements.
+type expr func(a *asm)
+
+func newAsm() *asm {
+	return &asm{labels: make(map[string]int), fixups: make(map[int]string)}
+}
+
+// do emits the provided statements.
+func (a *asm) do(statements ...expr) *asm {
+	for _, statement := range statements {
+		statement(a)
+	}
+	return a
+}
+
+// label emits a JUMPDEST marking the provided label.
+func (a *asm) label(name string) *asm {
+	a.labels[name] = len(a.code)
+	a.code = append(a.code, byte(vm.JUMPDEST))
+	return a
+}
+
+// bytes returns the assembled bytecode with label references resolved.
+func (a *asm) bytes() []byte {
+	for offset, name := range a.fixups {
+		target, ok := a.labels[name]
+		if !ok {
+			panic("undefined label " + name)
+		}
+		a.code[offset], a.code[offset+1] = byte(target>>8), byte(target)
+	}
+	return a.code
+}
+
+// op returns an expression applying the opcode on the provided arguments, the first argument ending up
+// on the top of the stack.
+func op(opcode vm.OpCode, args ...expr) expr {
+	return func(a *asm) {
+		for i := len(args) - 1; i >= 0; i-- {
+			args[i](a)
+		}
+		a.code = append(a.code, byte(opcode))
+	}
+}
+
+// num returns an expression pushing the provided value.
+func num(value *big.Int) expr {
+	return func(a *asm) {
+		data := value.Bytes()
+		if len(data) == 0 {
+			data = []byte{0}
+		}
+		a.code = append(a.code, byte(vm.PUSH1)+byte(len(data)-1))
+		a.code = append(a.code, data...)
+	}
+}
+
+// n returns an expression pushing the provided small value.
+func n(value uint64) expr {
+	return num(new(big.Int).SetUint64(value))
+}
+
+// ref returns an expression pushing the offset of the provided label.
+func ref(name string) expr {
+	return func(a *asm) {
+		a.code = append(a.code, byte(vm.PUSH2))
+		a.fixups[len(a.code)] = name
+		a.code = append(a.code, 0, 0)
+	}
+}
+
+// selector returns an expression pushing the selector of the provided function signature.
+func selector(signature string) expr {
+	return num(new(big.Int).SetBytes(crypto.Keccak256([]byte(signature))[:4]))
+}
+
+// eventTopic returns an expression pushing the topic of the provided event signature.
+func eventTopic(signature string) expr {
+	return num(new(big.Int).SetBytes(crypto.Keccak256([]byte(signature))))
+}
+
+// arg returns an expression loading the calldata argument at the provided position.
+func arg(idx uint64) expr {
+	return op(vm.CALLDATALOAD, n(4+32*idx))
+}
+
+// jump returns a statement jumping to the label.
+func jump(name string) expr {
+	return op(vm.JUMP, ref(name))
+}
+
+// jumpIf returns a statement jumping to the label if the condition is non-zero.
+func jumpIf(name string, condition expr) expr {
+	return op(vm.JUMPI, ref(name), condition)
+}
+
+// store returns a statement storing the value at the storage slot.
+func store(slot expr, value expr) expr {
+	return op(vm.SSTORE, slot, value)
+}
+
+// load returns an expression loading the storage slot.
+func load(slot expr) expr {
+	return op(vm.SLOAD, slot)
+}
+
+// hash returns an expression hashing the two provided words, used as mapping slots.
+func hash(a, b expr) expr {
+	return func(asm *asm) {
+		asm.do(op(vm.MSTORE, n(0), a), op(vm.MSTORE, n(32), b))
+		op(vm.KECCAK256, n(0), n(64))(asm)
+	}
+}
+
+// returnWord returns a statement returning the provided value as a single word.
+func returnWord(value expr) expr {
+	return func(a *asm) {
+		a.do(op(vm.MSTORE, n(0), value), op(vm.RETURN, n(0), n(32)))
+	}
+}
+
+// stop returns a statement ending the execution without return data.
+func stop() expr {
+	return op(vm.STOP)
+}
+
+// revert returns a statement reverting without return data.
+func revert() expr {
+	return op(vm.REVERT, n(0), n(0))
+}
+
+// log returns a statement emitting the event with the provided topics and a single data word, or no data if nil.
+func log(data expr, topics ...expr) expr {
+	return func(a *asm) {
+		size := n(0)
+		if data != nil {
+			op(vm.MSTORE, n(0), data)(a)
+			size = n(32)
+		}
+		op(vm.LOG0+vm.OpCode(len(topics)), append([]expr{n(0), size}, topics...)...)(a)
+	}
+}
+
+// dispatch returns a statement jumping to the label of the function matching the calldata selector,
+// reverting if none matches.
+func dispatch(functions map[string]string) expr {
+	return func(a *asm) {
+		for signature, label := range functions {
+			a.do(jumpIf(label, op(vm.EQ, selector(signature), op(vm.SHR, n(224), op(vm.CALLDATALOAD, n(0))))))
+		}
+		a.do(revert())
+	}
+}
+
+// deployable returns creation bytecode executing the constructor and deploying the runtime bytecode.
+func deployable(constructor *asm, runtime []byte) []byte {
+	code := constructor.bytes()
+
+	// CODECOPY(0, offset, size) and RETURN(0, size), with sizes fixed to PUSH2 so the offset is known upfront.
+	offset := len(code) + 15
+	size := []byte{byte(len(runtime) >> 8), byte(len(runtime))}
+	code = append(code, byte(vm.PUSH2), size[0], size[1], byte(vm.PUSH2), byte(offset>>8), byte(offset), byte(vm.PUSH1), 0, byte(vm.CODECOPY))
+	code = append(code, byte(vm.PUSH2), size[0], size[1], byte(vm.PUSH1), 0, byte(vm.RETURN))
+
+	return append(code, runtime...)
+}
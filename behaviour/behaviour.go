@@ -0,0 +1,155 @@
+// Package behaviour checks how a contract behaves at runtime, as signature matches alone do not prove that a
+// contract complies with a standard, e.g. an ERC-20 transfer returning false instead of reverting, or charging
+// a fee on transfers. Creation bytecode is deployed into an embedded in-memory EVM and scripted conformance
+// scenarios of the standard are executed against it, reported alongside the static discovery of the contract.
+package behaviour
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// DefaultGasLimit is the gas limit of each call made while executing scenarios.
+const DefaultGasLimit = 30_000_000
+
+// Status represents the outcome of a scenario.
+type Status string
+
+const (
+	StatusPassed  Status = "passed"  // Contract behaved as the standard requires.
+	StatusFailed  Status = "failed"  // Contract deviated from the standard.
+	StatusSkipped Status = "skipped" // Scenario could not be executed, e.g. the deployer holds no tokens.
+)
+
+// Scenario represents a single scripted conformance check of a standard.
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	run         func(s *session) error
+}
+
+// Result represents the outcome of a single scenario.
+type Result struct {
+	Scenario string `json:"scenario"`          // Name of the scenario.
+	Status   Status `json:"status"`            // Outcome of the scenario.
+	Message  string `json:"message,omitempty"` // Reason of the failure or skip.
+}
+
+// Report represents the outcome of behavioural checks of a single contract against a single standard.
+type Report struct {
+	Standard  shared.Standard  `json:"standard"`
+	Contract  string           `json:"contract"`
+	Address   string           `json:"address"`   // Address the contract was deployed at within the local EVM.
+	Discovery shared.Discovery `json:"discovery"` // Static discovery out of the deployed runtime bytecode.
+	Results   []Result         `json:"results"`
+}
+
+// Passed returns a boolean indicating whether no scenario failed. Skipped scenarios do not fail the report.
+func (r *Report) Passed() bool {
+	return r.Count(StatusFailed) == 0
+}
+
+// Count returns the number of results of the provided status.
+func (r *Report) Count(status Status) int {
+	toReturn := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			toReturn++
+		}
+	}
+	return toReturn
+}
+
+// Options holds the options of behavioural checks.
+type Options struct {
+	// Name is the name of the checked contract, used in the report and the static discovery.
+	Name string
+
+	// Setup holds calldata of calls sent by the deployer right after deployment, e.g. to mint tokens to itself.
+	Setup [][]byte
+
+	// TokenID is the identifier of a non-fungible token held by the deployer after setup. If not provided,
+	// it is looked up with tokenOfOwnerByIndex for enumerable contracts.
+	TokenID *big.Int
+
+	// GasLimit is the gas limit of each call. Defaults to DefaultGasLimit.
+	GasLimit uint64
+
+	// Confidence holds the options of the static confidence check.
+	Confidence confidence.Options
+}
+
+// suites holds scenarios of standards supporting behavioural checks.
+var suites = map[shared.Standard][]Scenario{
+	standards.ERC20:  erc20Scenarios,
+	standards.ERC721: erc721Scenarios,
+}
+
+// Supported returns a boolean indicating whether the standard supports behavioural checks.
+func Supported(standard shared.Standard) bool {
+	_, ok := suites[standard]
+	return ok
+}
+
+// Scenarios returns scenarios of the standard, or nil if it does not support behavioural checks.
+func Scenarios(standard shared.Standard) []Scenario {
+	return suites[standard]
+}
+
+// Check deploys the creation bytecode, executes setup calls and runs every scenario of the standard on its own copy
+// of the resulting state. It returns an error if the standard has no scenarios or the contract cannot be set up,
+// while deviations from the standard are reported as failed scenario results.
+func Check(standard shared.Standard, code []byte, opts Options) (*Report, error) {
+	scenarios, ok := suites[standard]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrNoBehaviourSuite, standard)
+	}
+
+	eip, err := standards.GetContractByStandard(standard)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.GasLimit == 0 {
+		opts.GasLimit = DefaultGasLimit
+	}
+
+	c, err := newChain(opts.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	address, runtimeCode, err := c.deploy(code)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range opts.Setup {
+		if r := c.call(deployer, address, input); r.reverted() {
+			return nil, fmt.Errorf("failure to execute setup call %d: %w", i, r.err)
+		}
+	}
+
+	contract := standards.NewContractMatcherFromBytecode(opts.Name, runtimeCode)
+	discovery, _ := confidence.ConfidenceCheckWithOptions(eip, contract, opts.Confidence)
+
+	toReturn := &Report{
+		Standard:  standard,
+		Contract:  opts.Name,
+		Address:   address.Hex(),
+		Discovery: discovery,
+		Results:   make([]Result, 0, len(scenarios)),
+	}
+
+	for _, scenario := range scenarios {
+		s := &session{chain: c.copy(), token: address, opts: opts}
+		toReturn.Results = append(toReturn.Results, s.run(scenario))
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,207 @@
+package behaviour
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/errors"
+)
+
+// statuses returns statuses of the report results keyed by the scenario name.
+func statuses(report *Report) map[string]Status {
+	toReturn := make(map[string]Status)
+	for _, result := range report.Results {
+		toReturn[result.Scenario] = result.Status
+	}
+	return toReturn
+}
+
+// message returns the message of the scenario result.
+func message(report *Report, scenario string) string {
+	for _, result := range report.Results {
+		if result.Scenario == scenario {
+			return result.Message
+		}
+	}
+	return ""
+}
+
+func TestCheckERC20(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    []byte
+		failed  map[string]string // Messages of failed scenarios keyed by the scenario name.
+		skipped []string
+		passed  bool
+	}{
+		{
+			name:   "Compliant",
+			code:   erc20TestToken(1000, erc20Quirks{}),
+			failed: map[string]string{},
+			passed: true,
+		},
+		{
+			name: "ReturnsFalse",
+			code: erc20TestToken(1000, erc20Quirks{returnFalse: true}),
+			failed: map[string]string{
+				"transfer-insufficient-balance":     "transfer(address,uint256) returned false instead of reverting while transferring more than the sender balance",
+				"transfer-from-exceeding-allowance": "transferFrom(address,address,uint256) returned false instead of reverting while transferring more than the allowance",
+			},
+		},
+		{
+			name: "FeeOnTransfer",
+			code: erc20TestToken(1000, erc20Quirks{fee: true}),
+			failed: map[string]string{
+				"transfer":      "recipient balance changed by 99 instead of 100, e.g. due to a fee on transfer",
+				"transfer-from": "recipient balance changed by 99 instead of 100, e.g. due to a fee on transfer",
+			},
+		},
+		{
+			name: "NoReturnValue",
+			code: erc20TestToken(1000, erc20Quirks{noReturn: true}),
+			failed: map[string]string{
+				"transfer":                          "transfer(address,uint256) returned no value, while the standard requires a bool",
+				"transfer-zero":                     "transfer(address,uint256) returned no value, while the standard requires a bool",
+				"approve":                           "approve(address,uint256) returned no value, while the standard requires a bool",
+				"transfer-from":                     "approve(address,uint256) returned no value, while the standard requires a bool",
+				"transfer-from-exceeding-allowance": "approve(address,uint256) returned no value, while the standard requires a bool",
+			},
+		},
+		{
+			name:    "NoSupply",
+			code:    erc20TestToken(0, erc20Quirks{}),
+			failed:  map[string]string{},
+			skipped: []string{"transfer", "approve", "transfer-from", "transfer-from-exceeding-allowance"},
+			passed:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Check(standards.ERC20, tt.code, Options{Name: "Token"})
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, standards.ERC20, report.Standard)
+			assert.Equal(t, "Token", report.Contract)
+			assert.NotEmpty(t, report.Address)
+			assert.Equal(t, standards.ERC20, report.Discovery.Standard)
+			assert.Equal(t, 6, len(report.Discovery.Contract.Functions))
+			assert.Len(t, report.Results, len(Scenarios(standards.ERC20)))
+			assert.Equal(t, tt.passed, report.Passed())
+
+			for scenario, status := range statuses(report) {
+				switch {
+				case tt.failed[scenario] != "":
+					assert.Equal(t, StatusFailed, status, scenario)
+					assert.Equal(t, tt.failed[scenario], message(report, scenario), scenario)
+				case contains(tt.skipped, scenario):
+					assert.Equal(t, StatusSkipped, status, scenario)
+					assert.Equal(t, "deployer holds no tokens after setup", message(report, scenario), scenario)
+				default:
+					assert.Equal(t, StatusPassed, status, scenario)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckERC721(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    []byte
+		opts    Options
+		failed  []string
+		skipped []string
+	}{
+		{
+			name: "Compliant",
+			code: erc721TestToken(erc721Quirks{}),
+			opts: Options{TokenID: big.NewInt(1)},
+		},
+		{
+			name: "UnknownToken",
+			code: erc721TestToken(erc721Quirks{}),
+			skipped: []string{
+				"transfer-from", "transfer-from-unauthorized", "approve", "set-approval-for-all",
+				"safe-transfer-from", "safe-transfer-from-non-receiver",
+			},
+		},
+		{
+			name: "Setup",
+			code: erc721TestToken(erc721Quirks{}),
+			opts: Options{
+				TokenID: big.NewInt(2),
+				Setup:   [][]byte{calldata(erc721TransferFrom, deployer, alice, big.NewInt(1))},
+			},
+		},
+		{
+			name:    "TokenNotHeld",
+			code:    erc721TestToken(erc721Quirks{}),
+			opts:    Options{TokenID: big.NewInt(1), Setup: [][]byte{calldata(erc721TransferFrom, deployer, alice, big.NewInt(1))}},
+			failed:  []string{},
+			skipped: []string{"transfer-from", "transfer-from-unauthorized", "approve", "set-approval-for-all", "safe-transfer-from", "safe-transfer-from-non-receiver"},
+		},
+		{
+			name:   "Unauthorized",
+			code:   erc721TestToken(erc721Quirks{anyone: true}),
+			opts:   Options{TokenID: big.NewInt(1)},
+			failed: []string{"transfer-from-unauthorized"},
+		},
+		{
+			name:   "UncheckedReceiver",
+			code:   erc721TestToken(erc721Quirks{unchecked: true}),
+			opts:   Options{TokenID: big.NewInt(1)},
+			failed: []string{"safe-transfer-from-non-receiver"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Check(standards.ERC721, tt.code, tt.opts)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, len(tt.failed) == 0, report.Passed())
+			assert.Equal(t, len(tt.failed), report.Count(StatusFailed))
+			assert.Equal(t, len(tt.skipped), report.Count(StatusSkipped))
+
+			for scenario, status := range statuses(report) {
+				switch {
+				case contains(tt.failed, scenario):
+					assert.Equal(t, StatusFailed, status, scenario)
+				case contains(tt.skipped, scenario):
+					assert.Equal(t, StatusSkipped, status, scenario)
+				default:
+					assert.Equal(t, StatusPassed, status, "%s: %s", scenario, message(report, scenario))
+				}
+			}
+		})
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	_, err := Check(standards.ERC1155, erc20TestToken(1000, erc20Quirks{}), Options{})
+	assert.ErrorIs(t, err, errors.ErrNoBehaviourSuite)
+	assert.False(t, Supported(standards.ERC1155))
+	assert.True(t, Supported(standards.ERC20))
+
+	_, err = Check(standards.ERC20, []byte{0xfe}, Options{})
+	assert.ErrorContains(t, err, "failure to deploy contract")
+
+	_, err = Check(standards.ERC20, erc20TestToken(1000, erc20Quirks{}), Options{Setup: [][]byte{{0xde, 0xad, 0xbe, 0xef}}})
+	assert.ErrorContains(t, err, "failure to execute setup call 0")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
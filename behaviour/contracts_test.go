@@ -0,0 +1,199 @@
+package behaviour
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// erc20Quirks holds deviations from ERC-20 built into the test token.
+type erc20Quirks struct {
+	returnFalse bool // Failing transfers return false instead of reverting.
+	noReturn    bool // Transfers and approvals return no value.
+	fee         bool // Recipients receive 99% of transferred amounts.
+}
+
+// Storage slots of the test tokens. Balances are stored at the account address itself.
+var (
+	supplySlot   = new(big.Int).Lsh(big.NewInt(1), 255)
+	ownerSlot    = new(big.Int).Lsh(big.NewInt(1), 250)
+	approvalSlot = new(big.Int).Lsh(big.NewInt(1), 251)
+)
+
+// erc20TestToken returns creation bytecode of an ERC-20 token minting the supply to the deployer.
+func erc20TestToken(supply uint64, quirks erc20Quirks) []byte {
+	caller := op(vm.CALLER)
+
+	received := func(amount expr) expr {
+		if quirks.fee {
+			return op(vm.SUB, amount, op(vm.DIV, amount, n(100)))
+		}
+		return amount
+	}
+
+	success := returnWord(n(1))
+	if quirks.noReturn {
+		success = stop()
+	}
+
+	failure := revert()
+	if quirks.returnFalse {
+		failure = returnWord(n(0))
+	}
+
+	runtime := newAsm().do(dispatch(map[string]string{
+		erc20TotalSupply:  "totalSupply",
+		erc20BalanceOf:    "balanceOf",
+		erc20Allowance:    "allowance",
+		erc20Transfer:     "transfer",
+		erc20Approve:      "approve",
+		erc20TransferFrom: "transferFrom",
+	}))
+
+	runtime.label("totalSupply").do(returnWord(load(num(supplySlot))))
+	runtime.label("balanceOf").do(returnWord(load(arg(0))))
+	runtime.label("allowance").do(returnWord(load(hash(arg(0), arg(1)))))
+
+	runtime.label("transfer").do(
+		jumpIf("fail", op(vm.LT, load(caller), arg(1))),
+		store(caller, op(vm.SUB, load(caller), arg(1))),
+		store(arg(0), op(vm.ADD, load(arg(0)), received(arg(1)))),
+		log(arg(1), eventTopic(erc20TransferLog), caller, arg(0)),
+		success,
+	)
+
+	runtime.label("approve").do(
+		store(hash(caller, arg(0)), arg(1)),
+		log(arg(1), eventTopic(erc20ApprovalLog), caller, arg(0)),
+		success,
+	)
+
+	runtime.label("transferFrom").do(
+		jumpIf("fail", op(vm.LT, load(hash(arg(0), caller)), arg(2))),
+		jumpIf("fail", op(vm.LT, load(arg(0)), arg(2))),
+		store(hash(arg(0), caller), op(vm.SUB, load(hash(arg(0), caller)), arg(2))),
+		store(arg(0), op(vm.SUB, load(arg(0)), arg(2))),
+		store(arg(1), op(vm.ADD, load(arg(1)), received(arg(2)))),
+		log(arg(2), eventTopic(erc20TransferLog), arg(0), arg(1)),
+		success,
+	)
+
+	runtime.label("fail").do(failure)
+
+	constructor := newAsm().do(
+		store(caller, n(supply)),
+		store(num(supplySlot), n(supply)),
+	)
+
+	return deployable(constructor, runtime.bytes())
+}
+
+// erc721Quirks holds deviations from ERC-721 built into the test token.
+type erc721Quirks struct {
+	anyone    bool // Anyone can transfer any token.
+	unchecked bool // Safe transfers do not check the recipient.
+}
+
+// erc721TestToken returns creation bytecode of an ERC-721 token minting tokens 1 and 2 to the deployer.
+func erc721TestToken(quirks erc721Quirks) []byte {
+	caller := op(vm.CALLER)
+	owner := func(id expr) expr { return op(vm.ADD, num(ownerSlot), id) }
+	approval := func(id expr) expr { return op(vm.ADD, num(approvalSlot), id) }
+	interfaceID := op(vm.SHR, n(224), arg(0))
+
+	runtime := newAsm().do(dispatch(map[string]string{
+		erc721SupportsInterface: "supportsInterface",
+		erc721BalanceOf:         "balanceOf",
+		erc721OwnerOf:           "ownerOf",
+		erc721GetApproved:       "getApproved",
+		erc721IsApprovedForAll:  "isApprovedForAll",
+		erc721Approve:           "approve",
+		erc721SetApprovalForAll: "setApprovalForAll",
+		erc721TransferFrom:      "transferFrom",
+		erc721SafeTransferFrom:  "safeTransferFrom",
+	}))
+
+	runtime.label("supportsInterface").do(returnWord(op(vm.OR,
+		op(vm.EQ, interfaceID, n(0x01ffc9a7)),
+		op(vm.EQ, interfaceID, n(0x80ac58cd)),
+	)))
+	runtime.label("balanceOf").do(
+		jumpIf("fail", op(vm.ISZERO, arg(0))),
+		returnWord(load(arg(0))),
+	)
+	runtime.label("ownerOf").do(
+		jumpIf("fail", op(vm.ISZERO, load(owner(arg(0))))),
+		returnWord(load(owner(arg(0)))),
+	)
+	runtime.label("getApproved").do(returnWord(load(approval(arg(0)))))
+	runtime.label("isApprovedForAll").do(returnWord(load(hash(arg(0), arg(1)))))
+
+	runtime.label("approve").do(
+		jumpIf("approveAuthorized", op(vm.EQ, caller, load(owner(arg(1))))),
+		jumpIf("approveAuthorized", load(hash(load(owner(arg(1))), caller))),
+		jump("fail"),
+	)
+	runtime.label("approveAuthorized").do(
+		store(approval(arg(1)), arg(0)),
+		log(nil, eventTopic(erc721ApprovalLog), load(owner(arg(1))), arg(0), arg(1)),
+		stop(),
+	)
+
+	runtime.label("setApprovalForAll").do(
+		store(hash(caller, arg(0)), arg(1)),
+		log(arg(1), eventTopic(erc721ApprovalForAllLog), caller, arg(0)),
+		stop(),
+	)
+
+	// transfer emits the transfer shared by transferFrom and safeTransferFrom, continuing at the next label.
+	transfer := func(prefix string) {
+		runtime.do(jumpIf("fail", op(vm.ISZERO, op(vm.EQ, load(owner(arg(2))), arg(0)))))
+		if !quirks.anyone {
+			runtime.do(
+				jumpIf(prefix+"Authorized", op(vm.EQ, caller, arg(0))),
+				jumpIf(prefix+"Authorized", op(vm.EQ, caller, load(approval(arg(2))))),
+				jumpIf(prefix+"Authorized", load(hash(arg(0), caller))),
+				jump("fail"),
+			)
+		}
+		runtime.label(prefix+"Authorized").do(
+			store(approval(arg(2)), n(0)),
+			store(arg(0), op(vm.SUB, load(arg(0)), n(1))),
+			store(arg(1), op(vm.ADD, load(arg(1)), n(1))),
+			store(owner(arg(2)), arg(1)),
+			log(nil, eventTopic(erc721TransferLog), arg(0), arg(1), arg(2)),
+		)
+	}
+
+	runtime.label("transferFrom")
+	transfer("transferFrom")
+	runtime.do(stop())
+
+	runtime.label("safeTransferFrom")
+	transfer("safeTransferFrom")
+	if !quirks.unchecked {
+		runtime.do(
+			jumpIf("done", op(vm.ISZERO, op(vm.EXTCODESIZE, arg(1)))),
+			op(vm.MSTORE, n(0), op(vm.SHL, n(224), n(0x150b7a02))),
+			op(vm.MSTORE, n(4), caller),
+			op(vm.MSTORE, n(36), arg(0)),
+			op(vm.MSTORE, n(68), arg(2)),
+			op(vm.MSTORE, n(100), n(0x80)),
+			op(vm.MSTORE, n(132), n(0)),
+			jumpIf("fail", op(vm.ISZERO, op(vm.CALL, op(vm.GAS), arg(1), n(0), n(0), n(164), n(0), n(32)))),
+			jumpIf("fail", op(vm.LT, op(vm.RETURNDATASIZE), n(32))),
+			jumpIf("fail", op(vm.ISZERO, op(vm.EQ, op(vm.SHR, n(224), op(vm.MLOAD, n(0))), n(0x150b7a02)))),
+		)
+	}
+	runtime.label("done").do(stop())
+
+	runtime.label("fail").do(revert())
+
+	constructor := newAsm().do(
+		store(op(vm.ADD, num(ownerSlot), n(1)), caller),
+		store(op(vm.ADD, num(ownerSlot), n(2)), caller),
+		store(caller, n(2)),
+	)
+
+	return deployable(constructor, runtime.bytes())
+}
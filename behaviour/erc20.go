@@ -0,0 +1,228 @@
+package behaviour
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ERC-20 function and event signatures used by the scenarios.
+const (
+	erc20TotalSupply  = "totalSupply()"
+	erc20BalanceOf    = "balanceOf(address)"
+	erc20Allowance    = "allowance(address,address)"
+	erc20Transfer     = "transfer(address,uint256)"
+	erc20Approve      = "approve(address,uint256)"
+	erc20TransferFrom = "transferFrom(address,address,uint256)"
+	erc20TransferLog  = "Transfer(address,address,uint256)"
+	erc20ApprovalLog  = "Approval(address,address,uint256)"
+)
+
+// erc20Scenarios holds ERC-20 conformance scenarios.
+var erc20Scenarios = []Scenario{
+	{
+		Name:        "total-supply",
+		Description: "Total supply is readable and covers the balance of the deployer.",
+		run:         erc20TotalSupplyScenario,
+	},
+	{
+		Name:        "transfer",
+		Description: "Transfer returns true, moves exactly the transferred amount and emits Transfer.",
+		run:         erc20TransferScenario,
+	},
+	{
+		Name:        "transfer-zero",
+		Description: "Transfer of zero tokens is treated as a normal transfer.",
+		run:         erc20TransferZeroScenario,
+	},
+	{
+		Name:        "transfer-insufficient-balance",
+		Description: "Transfer exceeding the sender balance reverts instead of returning false.",
+		run:         erc20TransferInsufficientBalanceScenario,
+	},
+	{
+		Name:        "approve",
+		Description: "Approve returns true, sets the allowance and emits Approval.",
+		run:         erc20ApproveScenario,
+	},
+	{
+		Name:        "transfer-from",
+		Description: "TransferFrom within the allowance moves exactly the amount and spends the allowance.",
+		run:         erc20TransferFromScenario,
+	},
+	{
+		Name:        "transfer-from-exceeding-allowance",
+		Description: "TransferFrom exceeding the allowance reverts instead of returning false.",
+		run:         erc20TransferFromExceedingAllowanceScenario,
+	},
+}
+
+// erc20Amount returns the amount transferred by the scenarios, a tenth of the deployer balance.
+func erc20Amount(s *session) (*big.Int, error) {
+	balance, err := s.uint(erc20BalanceOf, deployer)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Sign() == 0 {
+		return nil, skip("deployer holds no tokens after setup")
+	}
+
+	toReturn := new(big.Int).Div(balance, big.NewInt(10))
+	if toReturn.Sign() == 0 {
+		toReturn = balance
+	}
+	return toReturn, nil
+}
+
+// erc20BalanceChange returns an error if the balance of the account, described by the role, did not change
+// by the provided delta.
+func erc20BalanceChange(s *session, role string, account common.Address, before *big.Int, delta *big.Int) error {
+	after, err := s.uint(erc20BalanceOf, account)
+	if err != nil {
+		return err
+	}
+
+	if actual := new(big.Int).Sub(after, before); actual.Cmp(delta) != 0 {
+		return fmt.Errorf("%s balance changed by %s instead of %s, e.g. due to a fee on transfer", role, actual, delta)
+	}
+	return nil
+}
+
+func erc20TotalSupplyScenario(s *session) error {
+	supply, err := s.uint(erc20TotalSupply)
+	if err != nil {
+		return err
+	}
+
+	balance, err := s.uint(erc20BalanceOf, deployer)
+	if err != nil {
+		return err
+	}
+
+	if supply.Cmp(balance) < 0 {
+		return fmt.Errorf("total supply %s is lower than the deployer balance %s", supply, balance)
+	}
+	return nil
+}
+
+func erc20TransferScenario(s *session) error {
+	amount, err := erc20Amount(s)
+	if err != nil {
+		return err
+	}
+
+	senderBefore, err := s.uint(erc20BalanceOf, deployer)
+	if err != nil {
+		return err
+	}
+	recipientBefore, err := s.uint(erc20BalanceOf, alice)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc20Transfer, alice, amount)
+	if err := returnedTrue(r, erc20Transfer); err != nil {
+		return err
+	}
+	if err := erc20BalanceChange(s, "sender", deployer, senderBefore, new(big.Int).Neg(amount)); err != nil {
+		return err
+	}
+	if err := erc20BalanceChange(s, "recipient", alice, recipientBefore, amount); err != nil {
+		return err
+	}
+	return s.emitted(r, erc20TransferLog, []interface{}{deployer, alice}, amount)
+}
+
+func erc20TransferZeroScenario(s *session) error {
+	r := s.send(deployer, erc20Transfer, alice, new(big.Int))
+	if err := returnedTrue(r, erc20Transfer); err != nil {
+		return err
+	}
+	return s.emitted(r, erc20TransferLog, []interface{}{deployer, alice}, new(big.Int))
+}
+
+func erc20TransferInsufficientBalanceScenario(s *session) error {
+	balance, err := s.uint(erc20BalanceOf, alice)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(alice, erc20Transfer, bob, new(big.Int).Add(balance, common.Big1))
+	return reverted(r, erc20Transfer, "transferring more than the sender balance")
+}
+
+func erc20ApproveScenario(s *session) error {
+	amount, err := erc20Amount(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc20Approve, alice, amount)
+	if err := returnedTrue(r, erc20Approve); err != nil {
+		return err
+	}
+
+	allowance, err := s.uint(erc20Allowance, deployer, alice)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) != 0 {
+		return fmt.Errorf("allowance is %s after approving %s", allowance, amount)
+	}
+	return s.emitted(r, erc20ApprovalLog, []interface{}{deployer, alice}, amount)
+}
+
+func erc20TransferFromScenario(s *session) error {
+	amount, err := erc20Amount(s)
+	if err != nil {
+		return err
+	}
+
+	if err := returnedTrue(s.send(deployer, erc20Approve, alice, amount), erc20Approve); err != nil {
+		return err
+	}
+
+	ownerBefore, err := s.uint(erc20BalanceOf, deployer)
+	if err != nil {
+		return err
+	}
+	recipientBefore, err := s.uint(erc20BalanceOf, bob)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(alice, erc20TransferFrom, deployer, bob, amount)
+	if err := returnedTrue(r, erc20TransferFrom); err != nil {
+		return err
+	}
+	if err := erc20BalanceChange(s, "owner", deployer, ownerBefore, new(big.Int).Neg(amount)); err != nil {
+		return err
+	}
+	if err := erc20BalanceChange(s, "recipient", bob, recipientBefore, amount); err != nil {
+		return err
+	}
+
+	allowance, err := s.uint(erc20Allowance, deployer, alice)
+	if err != nil {
+		return err
+	}
+	if allowance.Sign() != 0 {
+		return fmt.Errorf("allowance is %s after spending all of it", allowance)
+	}
+	return s.emitted(r, erc20TransferLog, []interface{}{deployer, bob}, amount)
+}
+
+func erc20TransferFromExceedingAllowanceScenario(s *session) error {
+	amount, err := erc20Amount(s)
+	if err != nil {
+		return err
+	}
+
+	if err := returnedTrue(s.send(deployer, erc20Approve, alice, amount), erc20Approve); err != nil {
+		return err
+	}
+
+	r := s.send(alice, erc20TransferFrom, deployer, bob, new(big.Int).Add(amount, common.Big1))
+	return reverted(r, erc20TransferFrom, "transferring more than the allowance")
+}
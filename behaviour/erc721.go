@@ -0,0 +1,279 @@
+package behaviour
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ERC-721 function and event signatures used by the scenarios.
+const (
+	erc721SupportsInterface   = "supportsInterface(bytes4)"
+	erc721BalanceOf           = "balanceOf(address)"
+	erc721OwnerOf             = "ownerOf(uint256)"
+	erc721TokenOfOwnerByIndex = "tokenOfOwnerByIndex(address,uint256)"
+	erc721GetApproved         = "getApproved(uint256)"
+	erc721IsApprovedForAll    = "isApprovedForAll(address,address)"
+	erc721Approve             = "approve(address,uint256)"
+	erc721SetApprovalForAll   = "setApprovalForAll(address,bool)"
+	erc721TransferFrom        = "transferFrom(address,address,uint256)"
+	erc721SafeTransferFrom    = "safeTransferFrom(address,address,uint256)"
+	erc721TransferLog         = "Transfer(address,address,uint256)"
+	erc721ApprovalLog         = "Approval(address,address,uint256)"
+	erc721ApprovalForAllLog   = "ApprovalForAll(address,address,bool)"
+)
+
+// Interface identifiers checked by the supports-interface scenario.
+var (
+	erc165InterfaceID  = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	erc721InterfaceID  = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	invalidInterfaceID = [4]byte{0xff, 0xff, 0xff, 0xff}
+)
+
+// erc721Scenarios holds ERC-721 conformance scenarios.
+var erc721Scenarios = []Scenario{
+	{
+		Name:        "supports-interface",
+		Description: "ERC-165 reports support of ERC-165 and ERC-721, and no support of 0xffffffff.",
+		run:         erc721SupportsInterfaceScenario,
+	},
+	{
+		Name:        "balance-of-zero-address",
+		Description: "Balance of the zero address reverts.",
+		run:         erc721BalanceOfZeroAddressScenario,
+	},
+	{
+		Name:        "transfer-from",
+		Description: "TransferFrom by the owner moves the token, updates balances and emits Transfer.",
+		run:         erc721TransferFromScenario,
+	},
+	{
+		Name:        "transfer-from-unauthorized",
+		Description: "TransferFrom by an account that is neither the owner nor approved reverts.",
+		run:         erc721TransferFromUnauthorizedScenario,
+	},
+	{
+		Name:        "approve",
+		Description: "Approved account can transfer the token, clearing the approval.",
+		run:         erc721ApproveScenario,
+	},
+	{
+		Name:        "set-approval-for-all",
+		Description: "Operator approved for all can transfer the token.",
+		run:         erc721SetApprovalForAllScenario,
+	},
+	{
+		Name:        "safe-transfer-from",
+		Description: "SafeTransferFrom to an externally owned account moves the token.",
+		run:         erc721SafeTransferFromScenario,
+	},
+	{
+		Name:        "safe-transfer-from-non-receiver",
+		Description: "SafeTransferFrom to a contract not implementing onERC721Received reverts.",
+		run:         erc721SafeTransferFromNonReceiverScenario,
+	},
+}
+
+// erc721Token returns the identifier of a token held by the deployer, either provided by the options or
+// looked up with tokenOfOwnerByIndex.
+func erc721Token(s *session) (*big.Int, error) {
+	id := s.opts.TokenID
+	if id == nil {
+		var err error
+		if id, err = s.uint(erc721TokenOfOwnerByIndex, deployer, new(big.Int)); err != nil {
+			return nil, skip("token held by the deployer is unknown, see Options.TokenID")
+		}
+	}
+
+	owner, err := s.address(erc721OwnerOf, id)
+	if err != nil {
+		return nil, err
+	}
+	if owner != deployer {
+		return nil, skip("token %s is not held by the deployer after setup", id)
+	}
+	return id, nil
+}
+
+// erc721Transferred returns an error if the token was not moved from the deployer to the recipient by the call.
+func erc721Transferred(s *session, r *receipt, signature string, id *big.Int, to common.Address) error {
+	if err := succeeded(r, signature); err != nil {
+		return err
+	}
+
+	owner, err := s.address(erc721OwnerOf, id)
+	if err != nil {
+		return err
+	}
+	if owner != to {
+		return fmt.Errorf("token %s is owned by %s instead of %s after %s", id, owner.Hex(), to.Hex(), signature)
+	}
+	return s.emitted(r, erc721TransferLog, []interface{}{deployer, to, id})
+}
+
+// erc721BalanceChange returns an error if the balance of the account, described by the role, did not change
+// by the provided delta.
+func erc721BalanceChange(s *session, role string, account common.Address, before *big.Int, delta *big.Int) error {
+	after, err := s.uint(erc721BalanceOf, account)
+	if err != nil {
+		return err
+	}
+
+	if actual := new(big.Int).Sub(after, before); actual.Cmp(delta) != 0 {
+		return fmt.Errorf("%s balance changed by %s instead of %s", role, actual, delta)
+	}
+	return nil
+}
+
+func erc721SupportsInterfaceScenario(s *session) error {
+	for _, id := range [][4]byte{erc165InterfaceID, erc721InterfaceID} {
+		supported, err := s.bool(erc721SupportsInterface, id)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			return fmt.Errorf("interface %#x is reported as not supported", id)
+		}
+	}
+
+	supported, err := s.bool(erc721SupportsInterface, invalidInterfaceID)
+	if err != nil {
+		return err
+	}
+	if supported {
+		return fmt.Errorf("interface %#x is reported as supported", invalidInterfaceID)
+	}
+	return nil
+}
+
+func erc721BalanceOfZeroAddressScenario(s *session) error {
+	r := s.send(deployer, erc721BalanceOf, common.Address{})
+	if !r.reverted() {
+		return fmt.Errorf("%s succeeded for the zero address", erc721BalanceOf)
+	}
+	return nil
+}
+
+func erc721TransferFromScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	ownerBefore, err := s.uint(erc721BalanceOf, deployer)
+	if err != nil {
+		return err
+	}
+	recipientBefore, err := s.uint(erc721BalanceOf, alice)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc721TransferFrom, deployer, alice, id)
+	if err := erc721Transferred(s, r, erc721TransferFrom, id, alice); err != nil {
+		return err
+	}
+	if err := erc721BalanceChange(s, "owner", deployer, ownerBefore, big.NewInt(-1)); err != nil {
+		return err
+	}
+	return erc721BalanceChange(s, "recipient", alice, recipientBefore, common.Big1)
+}
+
+func erc721TransferFromUnauthorizedScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(alice, erc721TransferFrom, deployer, alice, id)
+	if !r.reverted() {
+		return fmt.Errorf("%s succeeded while transferring a token of another account without approval", erc721TransferFrom)
+	}
+	return nil
+}
+
+func erc721ApproveScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc721Approve, alice, id)
+	if err := succeeded(r, erc721Approve); err != nil {
+		return err
+	}
+	if err := s.emitted(r, erc721ApprovalLog, []interface{}{deployer, alice, id}); err != nil {
+		return err
+	}
+
+	approved, err := s.address(erc721GetApproved, id)
+	if err != nil {
+		return err
+	}
+	if approved != alice {
+		return fmt.Errorf("approved account is %s instead of %s", approved.Hex(), alice.Hex())
+	}
+
+	r = s.send(alice, erc721TransferFrom, deployer, bob, id)
+	if err := erc721Transferred(s, r, erc721TransferFrom, id, bob); err != nil {
+		return err
+	}
+
+	if approved, err = s.address(erc721GetApproved, id); err != nil {
+		return err
+	}
+	if approved != (common.Address{}) {
+		return fmt.Errorf("approval of %s was not cleared by the transfer", approved.Hex())
+	}
+	return nil
+}
+
+func erc721SetApprovalForAllScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc721SetApprovalForAll, alice, true)
+	if err := succeeded(r, erc721SetApprovalForAll); err != nil {
+		return err
+	}
+	if err := s.emitted(r, erc721ApprovalForAllLog, []interface{}{deployer, alice}, true); err != nil {
+		return err
+	}
+
+	approved, err := s.bool(erc721IsApprovedForAll, deployer, alice)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return fmt.Errorf("operator is not approved after %s", erc721SetApprovalForAll)
+	}
+
+	r = s.send(alice, erc721TransferFrom, deployer, bob, id)
+	return erc721Transferred(s, r, erc721TransferFrom, id, bob)
+}
+
+func erc721SafeTransferFromScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc721SafeTransferFrom, deployer, alice, id)
+	return erc721Transferred(s, r, erc721SafeTransferFrom, id, alice)
+}
+
+func erc721SafeTransferFromNonReceiverScenario(s *session) error {
+	id, err := erc721Token(s)
+	if err != nil {
+		return err
+	}
+
+	r := s.send(deployer, erc721SafeTransferFrom, deployer, nonReceiver, id)
+	if !r.reverted() {
+		return fmt.Errorf("%s succeeded while transferring to a contract not implementing onERC721Received", erc721SafeTransferFrom)
+	}
+	return nil
+}
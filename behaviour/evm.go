@@ -0,0 +1,141 @@
+package behaviour
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Accounts used by the scenarios. The deployer deploys the contract and holds its tokens after setup.
+var (
+	deployer    = common.HexToAddress("0x00000000000000000000000000000000000d3910")
+	alice       = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	bob         = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	nonReceiver = common.HexToAddress("0x00000000000000000000000000000000000c0de0") // Contract without any receiver hooks.
+)
+
+// receipt represents the outcome of a single call.
+type receipt struct {
+	ret  []byte
+	logs []*types.Log
+	err  error // Non-nil if the call reverted or failed otherwise, e.g. ran out of gas.
+}
+
+// reverted returns a boolean indicating whether the call reverted or failed.
+func (r *receipt) reverted() bool {
+	return r.err != nil
+}
+
+// chain represents an in-memory EVM state the scenarios are executed against.
+type chain struct {
+	state    *state.StateDB
+	gasLimit uint64
+	txs      uint64 // Number of executed calls, used to derive transaction hashes the logs are keyed by.
+}
+
+// newChain creates a new chain with an empty state, post Cancun rules and the non-receiver contract deployed.
+func newChain(gasLimit uint64) (*chain, error) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failure to create EVM state: %w", err)
+	}
+
+	// Contract returning nothing for any call, so receiver hooks invoked on it are never acknowledged.
+	statedb.SetCode(nonReceiver, []byte{byte(vm.STOP)})
+
+	return &chain{state: statedb, gasLimit: gasLimit}, nil
+}
+
+// config returns the runtime configuration of a call sent by the provided account.
+func (c *chain) config(from common.Address) *runtime.Config {
+	return &runtime.Config{
+		ChainConfig: params.MergedTestChainConfig,
+		Origin:      from,
+		BlockNumber: big.NewInt(1),
+		Time:        1,
+		GasLimit:    c.gasLimit,
+		Random:      &common.Hash{}, // Enables post merge rules, e.g. PUSH0 used by recent compilers.
+		State:       c.state,
+	}
+}
+
+// deploy executes the creation bytecode sent by the deployer and returns the contract address and runtime bytecode.
+func (c *chain) deploy(code []byte) (common.Address, []byte, error) {
+	c.begin()
+	runtimeCode, address, _, err := runtime.Create(code, c.config(deployer))
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failure to deploy contract: %w", err)
+	}
+	c.state.Finalise(true)
+
+	if len(runtimeCode) == 0 {
+		return common.Address{}, nil, errors.New("failure to deploy contract: empty runtime bytecode")
+	}
+	return address, runtimeCode, nil
+}
+
+// call executes the calldata against the contract as a transaction sent by the provided account.
+// State changes of reverted calls are discarded.
+func (c *chain) call(from common.Address, to common.Address, input []byte) *receipt {
+	hash := c.begin()
+	ret, _, err := runtime.Call(to, input, c.config(from))
+	c.state.Finalise(true)
+
+	return &receipt{ret: ret, logs: c.state.GetLogs(hash, 1, common.Hash{}), err: err}
+}
+
+// copy returns an independent copy of the chain, so scenarios never observe each other's state changes.
+func (c *chain) copy() *chain {
+	return &chain{state: c.state.Copy(), gasLimit: c.gasLimit, txs: c.txs}
+}
+
+// begin starts a new transaction and returns its hash.
+func (c *chain) begin() common.Hash {
+	c.txs++
+	hash := common.BigToHash(new(big.Int).SetUint64(c.txs))
+	c.state.SetTxContext(hash, int(c.txs))
+	return hash
+}
+
+// calldata encodes the call of the function with the provided signature, e.g. "transfer(address,uint256)".
+// Arguments can only be of static types, encoded as a single word each.
+func calldata(signature string, args ...interface{}) []byte {
+	toReturn := append([]byte{}, crypto.Keccak256([]byte(signature))[:4]...)
+	for _, arg := range args {
+		toReturn = append(toReturn, encode(arg)...)
+	}
+	return toReturn
+}
+
+// encode encodes the argument as a single ABI word.
+func encode(arg interface{}) []byte {
+	switch value := arg.(type) {
+	case common.Address:
+		return common.LeftPadBytes(value.Bytes(), 32)
+	case *big.Int:
+		return common.LeftPadBytes(value.Bytes(), 32)
+	case bool:
+		if value {
+			return common.LeftPadBytes([]byte{1}, 32)
+		}
+		return make([]byte, 32)
+	case [4]byte:
+		return common.RightPadBytes(value[:], 32)
+	default:
+		panic(fmt.Sprintf("unsupported argument type %T", arg))
+	}
+}
+
+// topic returns the topic of the event with the provided signature, e.g. "Transfer(address,address,uint256)".
+func topic(signature string) common.Hash {
+	return crypto.Keccak256Hash([]byte(signature))
+}
@@ -0,0 +1,165 @@
+package behaviour
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// skipError is returned by scenarios that cannot be executed against the contract.
+type skipError struct {
+	message string
+}
+
+// Error returns the reason the scenario was skipped.
+func (e *skipError) Error() string {
+	return e.message
+}
+
+// skip returns an error marking the scenario as skipped for the provided reason.
+func skip(format string, args ...interface{}) error {
+	return &skipError{message: fmt.Sprintf(format, args...)}
+}
+
+// session holds the state of a single scenario execution.
+type session struct {
+	chain *chain
+	token common.Address
+	opts  Options
+}
+
+// run executes the scenario and returns its result.
+func (s *session) run(scenario Scenario) Result {
+	toReturn := Result{Scenario: scenario.Name, Status: StatusPassed}
+
+	var skipped *skipError
+	if err := scenario.run(s); errors.As(err, &skipped) {
+		toReturn.Status = StatusSkipped
+		toReturn.Message = skipped.message
+	} else if err != nil {
+		toReturn.Status = StatusFailed
+		toReturn.Message = err.Error()
+	}
+
+	return toReturn
+}
+
+// send calls the contract function with the provided signature as a transaction sent by the provided account.
+func (s *session) send(from common.Address, signature string, args ...interface{}) *receipt {
+	return s.chain.call(from, s.token, calldata(signature, args...))
+}
+
+// word calls the contract function with the provided signature and returns the first word it returned.
+func (s *session) word(signature string, args ...interface{}) ([]byte, error) {
+	r := s.send(deployer, signature, args...)
+	if r.reverted() {
+		return nil, fmt.Errorf("%s reverted: %s", signature, r.err)
+	}
+	if len(r.ret) < 32 {
+		return nil, fmt.Errorf("%s returned %d bytes instead of a word", signature, len(r.ret))
+	}
+	return r.ret[:32], nil
+}
+
+// uint calls the contract function with the provided signature and returns its uint256 result.
+func (s *session) uint(signature string, args ...interface{}) (*big.Int, error) {
+	word, err := s.word(signature, args...)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(word), nil
+}
+
+// address calls the contract function with the provided signature and returns its address result.
+func (s *session) address(signature string, args ...interface{}) (common.Address, error) {
+	word, err := s.word(signature, args...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(word), nil
+}
+
+// bool calls the contract function with the provided signature and returns its bool result.
+func (s *session) bool(signature string, args ...interface{}) (bool, error) {
+	word, err := s.word(signature, args...)
+	if err != nil {
+		return false, err
+	}
+	return new(big.Int).SetBytes(word).Sign() != 0, nil
+}
+
+// succeeded returns an error if the call of the function with the provided signature reverted.
+func succeeded(r *receipt, signature string) error {
+	if r.reverted() {
+		return fmt.Errorf("%s reverted: %s", signature, r.err)
+	}
+	return nil
+}
+
+// returnedTrue returns an error if the call of the function with the provided signature did not succeed
+// returning true, as required of ERC-20 functions.
+func returnedTrue(r *receipt, signature string) error {
+	if err := succeeded(r, signature); err != nil {
+		return err
+	}
+
+	switch {
+	case len(r.ret) == 0:
+		return fmt.Errorf("%s returned no value, while the standard requires a bool", signature)
+	case len(r.ret) < 32 || new(big.Int).SetBytes(r.ret[:32]).Cmp(common.Big1) != 0:
+		return fmt.Errorf("%s returned %#x instead of true", signature, r.ret)
+	}
+	return nil
+}
+
+// reverted returns an error if the call of the function with the provided signature, described by the action,
+// did not revert. Calls returning false are reported separately, as callers ignoring the result are exploitable.
+func reverted(r *receipt, signature string, action string) error {
+	if r.reverted() {
+		return nil
+	}
+	if len(r.ret) >= 32 && new(big.Int).SetBytes(r.ret[:32]).Sign() == 0 {
+		return fmt.Errorf("%s returned false instead of reverting while %s", signature, action)
+	}
+	return fmt.Errorf("%s succeeded while %s", signature, action)
+}
+
+// emitted returns an error if the contract did not emit the event with the provided signature, indexed
+// parameters and data within the call.
+func (s *session) emitted(r *receipt, signature string, indexed []interface{}, data ...interface{}) error {
+	expected := make([]byte, 0, 32*len(data))
+	for _, arg := range data {
+		expected = append(expected, encode(arg)...)
+	}
+
+	found := false
+	for _, log := range r.logs {
+		if log.Address != s.token || len(log.Topics) == 0 || log.Topics[0] != topic(signature) {
+			continue
+		}
+		found = true
+
+		if len(log.Topics) != len(indexed)+1 || !bytes.Equal(log.Data, expected) {
+			continue
+		}
+
+		matched := true
+		for i, arg := range indexed {
+			if log.Topics[i+1] != common.BytesToHash(encode(arg)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return nil
+		}
+	}
+
+	if found {
+		return fmt.Errorf("event %s was emitted with unexpected parameters", signature)
+	}
+	return fmt.Errorf("event %s was not emitted", signature)
+}
@@ -0,0 +1,86 @@
+package standards
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/unpackdev/standards/bytecode"
+	"github.com/unpackdev/standards/shared"
+)
+
+// members holds function and error selectors and event topics of all known standards, mapped to their members.
+var members struct {
+	sync.Once
+	functions map[string]shared.Function
+	events    map[string]shared.Event
+	errors    map[string]shared.Error
+}
+
+// NewContractMatcherFromBytecode creates a new ContractMatcher out of the provided runtime bytecode. Selectors and
+// topics found in the bytecode are resolved against members of all known standards, so only members defined by at
+// least one standard can be recovered, without their state mutability. Whether event parameters are indexed is not
+// part of the topic, so events shared by several standards (e.g. ERC-20 and ERC-721 Transfer) take the parameters of
+// the first standard in a sorted order.
+func NewContractMatcherFromBytecode(name string, code []byte) *shared.ContractMatcher {
+	members.Do(indexMembers)
+
+	toReturn := &shared.ContractMatcher{
+		Name:      name,
+		Functions: make([]shared.Function, 0),
+		Events:    make([]shared.Event, 0),
+		Errors:    make([]shared.Error, 0),
+	}
+
+	for _, selector := range bytecode.Selectors(code) {
+		if fn, ok := members.functions[selector]; ok {
+			toReturn.Functions = append(toReturn.Functions, fn)
+		}
+		if e, ok := members.errors[selector]; ok {
+			toReturn.Errors = append(toReturn.Errors, e)
+		}
+	}
+
+	for _, topic := range bytecode.Topics(code) {
+		if event, ok := members.events[topic]; ok {
+			toReturn.Events = append(toReturn.Events, event)
+		}
+	}
+
+	return toReturn
+}
+
+// indexMembers populates members out of the known standards.
+func indexMembers() {
+	members.functions = make(map[string]shared.Function)
+	members.events = make(map[string]shared.Event)
+	members.errors = make(map[string]shared.Error)
+
+	// Standards are visited in a sorted order so members shared by several standards resolve deterministically.
+	names := make([]string, 0, len(standards))
+	for name := range standards {
+		names = append(names, name.String())
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		standard := standards[shared.Standard(name)]
+		for _, fn := range standard.Functions {
+			// Outputs are not part of the selector, the definition with the most outputs is kept as extra
+			// outputs never lower the confidence against standards that define fewer of them.
+			if existing, ok := members.functions[fn.Selector()]; !ok || len(fn.Outputs) > len(existing.Outputs) {
+				fn.StateMutability = ""
+				members.functions[fn.Selector()] = fn
+			}
+		}
+		for _, event := range standard.Events {
+			if _, ok := members.events[event.Topic()]; !ok {
+				members.events[event.Topic()] = event
+			}
+		}
+		for _, e := range standard.Errors {
+			if _, ok := members.errors[e.Selector()]; !ok {
+				members.errors[e.Selector()] = e
+			}
+		}
+	}
+}
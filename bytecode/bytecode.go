@@ -0,0 +1,89 @@
+// Package bytecode provides helpers to extract function selectors and event topics out of EVM bytecode,
+// so that contracts can be matched against standards when neither their ABI nor their source is available.
+package bytecode
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// opPush1 is the opcode of PUSH1, PUSH2 to PUSH32 follow it sequentially.
+	opPush1 = 0x60
+
+	// opPush4 is the opcode of PUSH4, used by the Solidity and Vyper dispatchers to push function selectors.
+	opPush4 = 0x63
+
+	// opPush32 is the opcode of PUSH32, used to push event topic hashes before emitting logs.
+	opPush32 = 0x7f
+)
+
+// Decode decodes the provided hex encoded bytecode, with or without the "0x" prefix and surrounding whitespace.
+func Decode(code string) ([]byte, error) {
+	code = strings.TrimPrefix(strings.TrimSpace(code), "0x")
+	toReturn, err := hex.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("failure to decode bytecode: %w", err)
+	}
+	return toReturn, nil
+}
+
+// IsHex reports whether the provided data looks like hex encoded bytecode, with or without the "0x" prefix.
+func IsHex(data []byte) bool {
+	code := strings.TrimPrefix(strings.TrimSpace(string(data)), "0x")
+	if len(code) == 0 || len(code)%2 != 0 {
+		return false
+	}
+
+	for _, c := range code {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// Selectors returns the hex encoded 4-byte values pushed by PUSH4 instructions, e.g. "0xa9059cbb", in order of
+// their first appearance. Every dispatched function selector is among them, together with custom error selectors
+// and any other 4-byte constants, so the result is meant to be resolved against known selectors.
+func Selectors(code []byte) []string {
+	return pushed(code, opPush4)
+}
+
+// Topics returns the hex encoded 32-byte values pushed by PUSH32 instructions in order of their first appearance.
+// Event topic hashes are among them, together with any other 32-byte constants.
+func Topics(code []byte) []string {
+	return pushed(code, opPush32)
+}
+
+// pushed returns unique hex encoded values pushed by the provided PUSH instruction. Data of all PUSH instructions
+// is skipped while walking the bytecode, so it is never interpreted as instructions.
+func pushed(code []byte, op byte) []string {
+	toReturn := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for pc := 0; pc < len(code); pc++ {
+		current := code[pc]
+		if current < opPush1 || current > opPush32 {
+			continue
+		}
+
+		size := int(current-opPush1) + 1
+		if pc+size >= len(code) {
+			break // Truncated push, usually the start of the metadata...
+		}
+
+		if current == op {
+			value := "0x" + hex.EncodeToString(code[pc+1:pc+1+size])
+			if !seen[value] {
+				seen[value] = true
+				toReturn = append(toReturn, value)
+			}
+		}
+
+		pc += size
+	}
+
+	return toReturn
+}
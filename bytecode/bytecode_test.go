@@ -0,0 +1,73 @@
+package bytecode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		selectors []string
+		topics    []string
+	}{
+		{
+			name:      "Dispatcher",
+			code:      "0x60003560e01c8063a9059cbb14601c57806318160ddd14601c57005b00",
+			selectors: []string{"0xa9059cbb", "0x18160ddd"},
+			topics:    []string{},
+		},
+		{
+			name:      "Push Data Is Skipped",
+			code:      "6163a9059cbb63095ea7b3",
+			selectors: []string{"0x095ea7b3"},
+			topics:    []string{},
+		},
+		{
+			name:      "Duplicates",
+			code:      "63a9059cbb63a9059cbb",
+			selectors: []string{"0xa9059cbb"},
+			topics:    []string{},
+		},
+		{
+			name:      "Topic",
+			code:      "7fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef00",
+			selectors: []string{},
+			topics:    []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		},
+		{
+			name:      "Truncated",
+			code:      "63a9059c",
+			selectors: []string{},
+			topics:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Decode(tt.code)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.selectors, Selectors(code))
+			assert.Equal(t, tt.topics, Topics(code))
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	code, err := Decode(" 0x6080\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x60, 0x80}, code)
+
+	_, err = Decode("0xzz")
+	assert.Error(t, err)
+
+	assert.True(t, IsHex([]byte("0x6080604052\n")))
+	assert.True(t, IsHex([]byte("6080604052")))
+	assert.False(t, IsHex([]byte("[{\"type\":\"function\"}]")))
+	assert.False(t, IsHex([]byte("0x608")))
+	assert.False(t, IsHex([]byte("")))
+}
@@ -0,0 +1,39 @@
+package standards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/bytecode"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestNewContractMatcherFromBytecode(t *testing.T) {
+	// Dispatcher pushing ERC-20 selectors, an unknown selector and the Transfer topic.
+	code, err := bytecode.Decode("0x" +
+		"8063a9059cbb14610100575b" + "806370a0823114610100575b" + "8063deadbeef14610100575b" +
+		"7fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa3" + "00")
+	assert.NoError(t, err)
+
+	contract := NewContractMatcherFromBytecode("Token", code)
+	assert.Equal(t, "Token", contract.Name)
+
+	signatures := make([]string, 0)
+	for _, fn := range contract.Functions {
+		signatures = append(signatures, fn.Signature())
+		assert.Empty(t, fn.StateMutability)
+	}
+	assert.Equal(t, []string{"transfer(address,uint256)", "balanceOf(address)"}, signatures)
+
+	if assert.Len(t, contract.Events, 1) {
+		assert.Equal(t, "Transfer(address,address,uint256)", contract.Events[0].Signature())
+	}
+	assert.Empty(t, contract.Errors)
+
+	// Definition with outputs is kept for selectors shared between standards.
+	for _, fn := range contract.Functions {
+		if fn.Name == "transfer" {
+			assert.Equal(t, []shared.Output{{Type: shared.TypeBool}}, fn.Outputs)
+		}
+	}
+}
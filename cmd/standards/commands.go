@@ -0,0 +1,538 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/shared"
+)
+
+// memberDetails represents a single standard member as displayed by the show command.
+type memberDetails struct {
+	Signature       string                 `json:"signature"`
+	Selector        string                 `json:"selector,omitempty"`
+	Topic           string                 `json:"topic,omitempty"`
+	StateMutability shared.StateMutability `json:"state_mutability,omitempty"`
+}
+
+// standardDetails represents a standard as displayed by the show command.
+type standardDetails struct {
+	Type        shared.Standard   `json:"type"`
+	Name        string            `json:"name"`
+	Url         string            `json:"url"`
+	EIP         int               `json:"eip,omitempty"`
+	Status      shared.Status     `json:"status,omitempty"`
+	Category    shared.Category   `json:"category,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Authors     []string          `json:"authors,omitempty"`
+	Requires    []shared.Standard `json:"requires,omitempty"`
+	Extends     []shared.Standard `json:"extends,omitempty"`
+	Created     string            `json:"created,omitempty"`
+	Version     string            `json:"version,omitempty"`
+	Versions    []string          `json:"versions,omitempty"`
+	InterfaceID string            `json:"interface_id"`
+	Functions   []memberDetails   `json:"functions"`
+	Events      []memberDetails   `json:"events"`
+	Errors      []memberDetails   `json:"errors"`
+}
+
+// detectOutput represents the result of the detect command.
+type detectOutput struct {
+	Contract    string             `json:"contract"`
+	Discoveries []shared.Discovery `json:"discoveries"`
+}
+
+// diffEntry represents a single compared member of the diff command.
+type diffEntry struct {
+	Status    string `json:"status"` // One of match, partial, missing or extra.
+	Kind      string `json:"kind"`   // One of function, event or error.
+	Signature string `json:"signature"`
+}
+
+// diffOutput represents the result of the diff command.
+type diffOutput struct {
+	Standard         shared.Standard        `json:"standard"`
+	Contract         string                 `json:"contract"`
+	Confidence       shared.ConfidenceLevel `json:"confidence"`
+	ConfidencePoints float64                `json:"confidence_points"`
+	Members          []diffEntry            `json:"members"`
+	Deviations       []shared.Deviation     `json:"deviations"`
+}
+
+// detectFlags holds flags shared by the detect and diff commands.
+type detectFlags struct {
+	format   string
+	input    string
+	contract string
+	strict   bool
+	fuzzy    bool
+}
+
+// register registers the detection flags into the provided flag set.
+func (f *detectFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.input, "format", inputAuto, "input format: auto, abi, bytecode or source")
+	fs.StringVar(&f.contract, "contract", "", "name of the contract to use out of solidity source, defaults to the last contract")
+	fs.BoolVar(&f.strict, "strict", false, "score function state mutability")
+	fs.BoolVar(&f.fuzzy, "fuzzy", false, "match lookalike member names")
+}
+
+// options returns the confidence options out of the detection flags.
+func (f *detectFlags) options() confidence.Options {
+	return confidence.Options{Strict: f.strict, Fuzzy: f.fuzzy}
+}
+
+// runList implements the list command.
+func runList(args []string, stdout, stderr io.Writer) error {
+	var format, category, status, tag string
+	query := standards.Query{}
+	fs := newFlagSet("list", stderr, &format)
+	fs.StringVar(&category, "category", "", "list only standards of the category, e.g. token or defi")
+	fs.StringVar(&status, "status", "", "list only standards of the EIP status, e.g. Final")
+	fs.StringVar(&tag, "tag", "", "list only standards with the tag, e.g. nft")
+	fs.StringVar(&query.Text, "search", "", "list only standards whose type or name contains the text")
+	fs.StringVar(&query.Function, "function", "", "list only standards declaring the function selector, signature or name")
+	fs.StringVar(&query.Event, "event", "", "list only standards declaring the event topic, signature or name")
+	fs.StringVar(&query.Error, "error", "", "list only standards declaring the error selector, signature or name")
+	extends := fs.String("extends", "", "list only standards extending the standard, e.g. ERC721")
+	if err := parseFlags(fs, args, 0, &format); err != nil {
+		return err
+	}
+
+	if category != "" {
+		query.Categories = []shared.Category{shared.Category(category)}
+	}
+	if status != "" {
+		query.Statuses = []shared.Status{shared.Status(status)}
+	}
+	if tag != "" {
+		query.Tags = []string{tag}
+	}
+	query.Extends = shared.Standard(strings.ToUpper(*extends))
+
+	eips, err := standards.QueryStandards(query)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case formatJSON:
+		items := make([]shared.ContractStandard, 0, len(eips))
+		for _, eip := range eips {
+			items = append(items, eip.GetStandard())
+		}
+		return writeJSON(stdout, items)
+	case formatProtoJSON:
+		items := make([]*eip_pb.ContractStandard, 0, len(eips))
+		for _, eip := range eips {
+			standard := eip.GetStandard()
+			items = append(items, standard.ToProto())
+		}
+		return writeProtoJSONList(stdout, items)
+	}
+
+	t := newTable(stdout, "STANDARD", "NAME", "FUNCTIONS", "EVENTS", "ERRORS")
+	for _, eip := range eips {
+		t.row(
+			eip.GetType().String(),
+			eip.GetName(),
+			strconv.Itoa(len(eip.GetFunctions())),
+			strconv.Itoa(len(eip.GetEvents())),
+			strconv.Itoa(len(eip.GetErrors())),
+		)
+	}
+	return t.flush()
+}
+
+// Supported output formats of the graph command.
+const (
+	graphDOT  = "dot"
+	graphJSON = "json"
+)
+
+// runGraph implements the graph command.
+func runGraph(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("graph", stderr, nil)
+	format := fs.String("o", graphDOT, "output format: dot or json")
+	if err := parseFlags(fs, args, 0, nil); err != nil {
+		return err
+	}
+
+	graph := standards.GetDependencyGraph()
+	switch *format {
+	case graphDOT:
+		return graph.WriteDOT(stdout)
+	case graphJSON:
+		return graph.WriteJSON(stdout)
+	}
+	return fmt.Errorf("unsupported output format %q", *format)
+}
+
+// runShow implements the show command.
+func runShow(args []string, stdout, stderr io.Writer) error {
+	var format string
+	fs := newFlagSet("show", stderr, &format)
+	if err := parseFlags(fs, args, 1, &format); err != nil {
+		return err
+	}
+
+	eip, err := lookupStandard(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	standard := eip.GetStandard()
+	if format == formatProtoJSON {
+		return writeProtoJSON(stdout, standard.ToProto())
+	}
+
+	details := standardDetails{
+		Type:        standard.Type,
+		Name:        standard.Name,
+		Url:         standard.Url,
+		EIP:         standard.EIP,
+		Status:      standard.Status,
+		Category:    standard.Category,
+		Tags:        standard.Tags,
+		Authors:     standard.Authors,
+		Requires:    standard.Requires,
+		Extends:     standard.Extends,
+		Created:     standard.Created,
+		Version:     standard.Version,
+		InterfaceID: shared.InterfaceID(standard.Functions),
+		Functions:   make([]memberDetails, 0, len(standard.Functions)),
+		Events:      make([]memberDetails, 0, len(standard.Events)),
+		Errors:      make([]memberDetails, 0, len(standard.Errors)),
+	}
+	for _, version := range standard.Versions {
+		details.Versions = append(details.Versions, version.Version)
+	}
+	for _, fn := range standard.Functions {
+		details.Functions = append(details.Functions, memberDetails{Signature: fn.Signature(), Selector: fn.Selector(), StateMutability: fn.StateMutability})
+	}
+	for _, event := range standard.Events {
+		details.Events = append(details.Events, memberDetails{Signature: event.Signature(), Topic: event.Topic()})
+	}
+	for _, e := range standard.Errors {
+		details.Errors = append(details.Errors, memberDetails{Signature: e.Signature(), Selector: e.Selector()})
+	}
+
+	if format == formatJSON {
+		return writeJSON(stdout, details)
+	}
+
+	t := newTable(stdout)
+	t.row("Standard:", details.Type.String())
+	t.row("Name:", details.Name)
+	t.row("URL:", details.Url)
+	t.row("Interface ID:", details.InterfaceID)
+	optionalRow(t, "EIP:", details.EIP, strconv.Itoa(details.EIP))
+	optionalRow(t, "Status:", details.Status, details.Status.String())
+	optionalRow(t, "Category:", details.Category, details.Category.String())
+	optionalRow(t, "Tags:", len(details.Tags), strings.Join(details.Tags, ", "))
+	optionalRow(t, "Authors:", len(details.Authors), strings.Join(details.Authors, ", "))
+	optionalRow(t, "Requires:", len(details.Requires), joinStandards(details.Requires))
+	optionalRow(t, "Extends:", len(details.Extends), joinStandards(details.Extends))
+	optionalRow(t, "Created:", details.Created, details.Created)
+	optionalRow(t, "Version:", details.Version, details.Version)
+	optionalRow(t, "Superseded:", len(details.Versions), strings.Join(details.Versions, ", "))
+	if err := t.flush(); err != nil {
+		return err
+	}
+
+	sections := []struct {
+		title   string
+		header  []string
+		members []memberDetails
+	}{
+		{title: "FUNCTIONS", header: []string{"SELECTOR", "SIGNATURE", "MUTABILITY"}, members: details.Functions},
+		{title: "EVENTS", header: []string{"TOPIC", "SIGNATURE"}, members: details.Events},
+		{title: "ERRORS", header: []string{"SELECTOR", "SIGNATURE"}, members: details.Errors},
+	}
+
+	for _, section := range sections {
+		if len(section.members) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(stdout, "\n%s\n", section.title)
+		t := newTable(stdout, section.header...)
+		for _, member := range section.members {
+			columns := []string{member.Selector + member.Topic, member.Signature, member.StateMutability.String()}
+			t.row(columns[:len(section.header)]...)
+		}
+		if err := t.flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runDetect implements the detect command.
+func runDetect(args []string, stdout, stderr io.Writer) error {
+	var flags detectFlags
+	var minimum string
+	var ambiguous bool
+	fs := newFlagSet("detect", stderr, &flags.format)
+	flags.register(fs)
+	fs.StringVar(&minimum, "min", shared.LowConfidence.String(), "minimum confidence level: none, low, medium, high or perfect")
+	fs.BoolVar(&ambiguous, "ambiguous", false, "keep mutually exclusive standards such as ERC20 and ERC721 both")
+	if err := parseFlags(fs, args, 1, &flags.format); err != nil {
+		return err
+	}
+
+	minimumLevel, err := parseConfidenceLevel(minimum)
+	if err != nil {
+		return err
+	}
+
+	contract, err := loadContract(fs.Arg(0), flags.input, flags.contract)
+	if err != nil {
+		return err
+	}
+
+	detector, err := standards.NewDetector(standards.DetectorOptions{Confidence: flags.options(), KeepAmbiguous: ambiguous})
+	if err != nil {
+		return err
+	}
+
+	discoveries, err := detector.Detect(contract)
+	if err != nil {
+		return err
+	}
+
+	output := detectOutput{Contract: contract.Name, Discoveries: make([]shared.Discovery, 0, len(discoveries))}
+	for _, discovery := range discoveries {
+		if discovery.Confidence >= minimumLevel {
+			output.Discoveries = append(output.Discoveries, discovery)
+		}
+	}
+
+	switch format := flags.format; format {
+	case formatJSON:
+		return writeJSON(stdout, output)
+	case formatProtoJSON:
+		items := make([]*eip_pb.Discovery, 0, len(output.Discoveries))
+		for _, discovery := range output.Discoveries {
+			items = append(items, discovery.ToProto())
+		}
+		return writeProtoJSONList(stdout, items)
+	}
+
+	if len(output.Discoveries) == 0 {
+		fmt.Fprintf(stdout, "No standards detected for %s.\n", output.Contract)
+		return nil
+	}
+
+	t := newTable(stdout, "RANK", "STANDARD", "VERSION", "CONFIDENCE", "POINTS", "TOKENS", "DEVIATIONS")
+	for i, discovery := range output.Discoveries {
+		version := discovery.Version
+		if version == "" {
+			version = "-"
+		} else if standards.IsOutdated(discovery) {
+			version += " (outdated)"
+		}
+
+		t.row(
+			strconv.Itoa(i+1),
+			discovery.Standard.String(),
+			version,
+			discovery.Confidence.String(),
+			strconv.FormatFloat(discovery.ConfidencePoints, 'f', 2, 64),
+			fmt.Sprintf("%d/%d", discovery.DiscoveredTokens, discovery.MaximumTokens),
+			strconv.Itoa(len(discovery.Deviations)),
+		)
+	}
+	return t.flush()
+}
+
+// runDiff implements the diff command.
+func runDiff(args []string, stdout, stderr io.Writer) error {
+	var flags detectFlags
+	fs := newFlagSet("diff", stderr, &flags.format)
+	flags.register(fs)
+	if err := parseFlags(fs, args, 2, &flags.format); err != nil {
+		return err
+	}
+
+	eip, err := lookupStandard(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	contract, err := loadContract(fs.Arg(1), flags.input, flags.contract)
+	if err != nil {
+		return err
+	}
+
+	discovery, _ := confidence.ConfidenceCheckWithOptions(eip, contract, flags.options())
+	if flags.format == formatProtoJSON {
+		return writeProtoJSON(stdout, discovery.ToProto())
+	}
+
+	output := diffOutput{
+		Standard:         discovery.Standard,
+		Contract:         contract.Name,
+		Confidence:       discovery.Confidence,
+		ConfidencePoints: discovery.ConfidencePoints,
+		Members:          diffMembers(eip, contract, discovery.Contract),
+		Deviations:       discovery.Deviations,
+	}
+
+	if flags.format == formatJSON {
+		return writeJSON(stdout, output)
+	}
+
+	t := newTable(stdout)
+	t.row("Standard:", output.Standard.String())
+	t.row("Contract:", output.Contract)
+	t.row("Confidence:", fmt.Sprintf("%s (%.2f)", output.Confidence, output.ConfidencePoints))
+	if err := t.flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout)
+	t = newTable(stdout, "STATUS", "KIND", "SIGNATURE")
+	for _, member := range output.Members {
+		t.row(member.Status, member.Kind, member.Signature)
+	}
+	if err := t.flush(); err != nil {
+		return err
+	}
+
+	if len(output.Deviations) > 0 {
+		fmt.Fprintln(stdout)
+		t = newTable(stdout, "DEVIATION", "MEMBER", "EXPECTED", "ACTUAL")
+		for _, deviation := range output.Deviations {
+			t.row(string(deviation.Kind), deviation.Member, deviation.Expected, deviation.Actual)
+		}
+		return t.flush()
+	}
+
+	return nil
+}
+
+// diffMembers compares standard members against the annotated contract of the discovery. Standard members are
+// listed first, as matched, partially matched or missing, followed by contract members the standard does not define.
+func diffMembers(eip shared.EIP, contract, annotated *shared.ContractMatcher) []diffEntry {
+	toReturn := make([]diffEntry, 0)
+	matched := map[string]bool{}
+
+	for i, fn := range eip.GetFunctions() {
+		status := memberStatus(annotated.Functions[i].Matched, inputsMatched(annotated.Functions[i].Inputs) && outputsMatched(annotated.Functions[i].Outputs))
+		if annotated.Functions[i].Matched {
+			matched["function:"+annotated.Functions[i].Name] = true
+		}
+		toReturn = append(toReturn, diffEntry{Status: status, Kind: "function", Signature: fn.Signature()})
+	}
+
+	for i, event := range eip.GetEvents() {
+		status := memberStatus(annotated.Events[i].Matched, inputsMatched(annotated.Events[i].Inputs))
+		if annotated.Events[i].Matched {
+			matched["event:"+annotated.Events[i].Name] = true
+		}
+		toReturn = append(toReturn, diffEntry{Status: status, Kind: "event", Signature: event.Signature()})
+	}
+
+	for i, e := range eip.GetErrors() {
+		status := memberStatus(annotated.Errors[i].Matched, inputsMatched(annotated.Errors[i].Inputs))
+		if annotated.Errors[i].Matched {
+			matched["error:"+annotated.Errors[i].Name] = true
+		}
+		toReturn = append(toReturn, diffEntry{Status: status, Kind: "error", Signature: e.Signature()})
+	}
+
+	for _, fn := range contract.Functions {
+		if !matched["function:"+fn.Name] {
+			toReturn = append(toReturn, diffEntry{Status: "extra", Kind: "function", Signature: fn.Signature()})
+		}
+	}
+	for _, event := range contract.Events {
+		if !matched["event:"+event.Name] {
+			toReturn = append(toReturn, diffEntry{Status: "extra", Kind: "event", Signature: event.Signature()})
+		}
+	}
+	for _, e := range contract.Errors {
+		if !matched["error:"+e.Name] {
+			toReturn = append(toReturn, diffEntry{Status: "extra", Kind: "error", Signature: e.Signature()})
+		}
+	}
+
+	return toReturn
+}
+
+// memberStatus returns the diff status of a standard member.
+func memberStatus(matched bool, complete bool) string {
+	switch {
+	case matched && complete:
+		return "match"
+	case matched:
+		return "partial"
+	default:
+		return "missing"
+	}
+}
+
+// inputsMatched reports whether all annotated inputs were matched.
+func inputsMatched(inputs []shared.Input) bool {
+	for _, input := range inputs {
+		if !input.Matched {
+			return false
+		}
+	}
+	return true
+}
+
+// outputsMatched reports whether all annotated outputs were matched.
+func outputsMatched(outputs []shared.Output) bool {
+	for _, output := range outputs {
+		if !output.Matched {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupStandard returns the standard with the provided, case-insensitive, name.
+func lookupStandard(name string) (shared.EIP, error) {
+	eip, err := standards.GetContractByStandard(shared.Standard(strings.ToUpper(name)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, name)
+	}
+	return eip, nil
+}
+
+// parseConfidenceLevel parses the confidence level out of its string representation.
+func parseConfidenceLevel(level string) (shared.ConfidenceLevel, error) {
+	for _, candidate := range []shared.ConfidenceLevel{
+		shared.NoConfidence, shared.LowConfidence, shared.MediumConfidence, shared.HighConfidence, shared.PerfectConfidence,
+	} {
+		if strings.EqualFold(candidate.String(), level) {
+			return candidate, nil
+		}
+	}
+	return shared.NoConfidence, fmt.Errorf("unsupported confidence level %q", level)
+}
+
+// optionalRow adds a table row with the value, unless the presence is the zero value.
+func optionalRow[T comparable](t *table, label string, presence T, value string) {
+	var zero T
+	if presence != zero {
+		t.row(label, value)
+	}
+}
+
+// joinStandards joins the standards into a comma separated list.
+func joinStandards(values []shared.Standard) string {
+	toReturn := make([]string, 0, len(values))
+	for _, value := range values {
+		toReturn = append(toReturn, value.String())
+	}
+	return strings.Join(toReturn, ", ")
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/bytecode"
+	"github.com/unpackdev/standards/shared"
+	"github.com/unpackdev/standards/solidity"
+)
+
+// Supported contract input formats.
+const (
+	inputAuto     = "auto"
+	inputABI      = "abi"
+	inputBytecode = "bytecode"
+	inputSource   = "source"
+)
+
+// loadContract loads the contract matcher out of the file at the provided path, or out of the standard input
+// if the path is "-". The input format is detected out of the file extension and content unless provided.
+func loadContract(path string, format string, contractName string) (*shared.ContractMatcher, error) {
+	data, err := readContract(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseContract(path, data, format, contractName)
+}
+
+// parseContract creates the contract matcher out of the data read from the provided path.
+func parseContract(path string, data []byte, format string, contractName string) (*shared.ContractMatcher, error) {
+	if format == inputAuto {
+		format = detectFormat(path, data)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if contractName != "" {
+		name = contractName
+	}
+
+	switch format {
+	case inputABI:
+		return shared.NewContractMatcherFromABI(name, unwrapArtifact(data))
+	case inputBytecode:
+		code, err := bytecode.Decode(string(data))
+		if err != nil {
+			return nil, err
+		}
+		return standards.NewContractMatcherFromBytecode(name, code), nil
+	case inputSource:
+		return solidity.NewContractMatcherFromSource(contractName, data)
+	default:
+		return nil, fmt.Errorf("unsupported input format %q, expected %s, %s, %s or %s", format, inputAuto, inputABI, inputBytecode, inputSource)
+	}
+}
+
+// readContract reads the file at the provided path, or the standard input if the path is "-".
+func readContract(path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failure to read contract: %w", err)
+	}
+	return data, nil
+}
+
+// detectFormat detects the input format out of the file extension, falling back to sniffing the content.
+func detectFormat(path string, data []byte) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".sol":
+		return inputSource
+	case ".json", ".abi":
+		return inputABI
+	case ".hex", ".bin":
+		return inputBytecode
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")), bytes.HasPrefix(trimmed, []byte("{")):
+		return inputABI
+	case bytecode.IsHex(trimmed):
+		return inputBytecode
+	default:
+		return inputSource
+	}
+}
+
+// unwrapArtifact extracts the ABI out of compilation artifacts (Hardhat, Foundry, Truffle) that hold it under
+// the "abi" key, returning any other data unchanged.
+func unwrapArtifact(data []byte) []byte {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return data
+	}
+
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(data, &artifact); err != nil || len(artifact.ABI) == 0 {
+		return data
+	}
+	return artifact.ABI
+}
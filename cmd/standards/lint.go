@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/unpackdev/standards/compliance"
+	"github.com/unpackdev/standards/shared"
+	"github.com/unpackdev/standards/solidity"
+)
+
+// Supported output formats of the lint command.
+const (
+	lintText  = "text"
+	lintSARIF = "sarif"
+	lintJSON  = "json"
+)
+
+// runLint implements the lint command. It fails if the contract does not comply with any of the standards.
+func runLint(args []string, stdout, stderr io.Writer) error {
+	var flags detectFlags
+	fs := newFlagSet("lint", stderr, nil)
+	format := fs.String("o", lintText, "output format: text, sarif or json")
+	fs.StringVar(&flags.input, "format", inputAuto, "input format: auto, abi, bytecode or source")
+	fs.StringVar(&flags.contract, "contract", "", "name of the contract to use out of solidity source, defaults to the last contract")
+	fs.BoolVar(&flags.strict, "strict", false, "check function state mutability")
+	if err := parseFlags(fs, args, 2, nil); err != nil {
+		return err
+	}
+
+	switch *format {
+	case lintText, lintSARIF, lintJSON:
+	default:
+		return fmt.Errorf("unsupported output format %q, expected %s, %s or %s", *format, lintText, lintSARIF, lintJSON)
+	}
+
+	eips := make([]shared.EIP, 0)
+	for _, name := range strings.Split(fs.Arg(0), ",") {
+		eip, err := lookupStandard(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+		eips = append(eips, eip)
+	}
+
+	check, err := loadCompliance(fs.Arg(1), flags)
+	if err != nil {
+		return err
+	}
+
+	reports := make([]*compliance.Report, 0, len(eips))
+	for _, eip := range eips {
+		reports = append(reports, check(eip))
+	}
+
+	switch *format {
+	case lintSARIF:
+		err = compliance.WriteSARIF(stdout, reports)
+	case lintJSON:
+		err = writeJSON(stdout, reports)
+	default:
+		err = compliance.WriteText(stdout, reports)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		if !report.Compliant() {
+			return fmt.Errorf("%s is not compliant with %s", report.Contract, report.Standard)
+		}
+	}
+	return nil
+}
+
+// loadCompliance loads the contract at the provided path and returns a function checking its compliance with
+// a standard. Diagnostics of Solidity sources point to member definitions, others only to the file.
+func loadCompliance(path string, flags detectFlags) (func(eip shared.EIP) *compliance.Report, error) {
+	opts := compliance.Options{Strict: flags.strict}
+	if path != "-" {
+		opts.File = path
+	}
+
+	data, err := readContract(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if input := flags.input; input != inputSource && (input != inputAuto || detectFormat(path, data) != inputSource) {
+		contract, err := parseContract(path, data, input, flags.contract)
+		if err != nil {
+			return nil, err
+		}
+		return func(eip shared.EIP) *compliance.Report { return compliance.Check(eip, contract, opts) }, nil
+	}
+
+	contracts, err := solidity.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := solidity.Find(contracts, flags.contract)
+	if contract == nil {
+		return nil, fmt.Errorf("contract %q not found in solidity source", flags.contract)
+	}
+	return func(eip shared.EIP) *compliance.Report { return compliance.CheckSolidity(eip, contract, opts) }, nil
+}
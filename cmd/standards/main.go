@@ -0,0 +1,145 @@
+// Command standards inspects registered Ethereum standards and detects which of them a contract implements,
+// out of its JSON ABI, runtime bytecode or Solidity source.
+//
+// Usage:
+//
+//	standards list [-o table|json|proto-json] [-category c] [-status s] [-tag t] [-search text] [-function f] [-event e] [-error e] [-extends standard]
+//	standards graph [-o dot|json]
+//	standards show [-o format] <standard>
+//	standards detect [-o format] [-format auto|abi|bytecode|source] [-contract name] [-strict] [-fuzzy] [-min level] [-ambiguous] <file>
+//	standards diff [-o format] [-format auto|abi|bytecode|source] [-contract name] [-strict] [-fuzzy] <standard> <file>
+//	standards lint [-o text|sarif|json] [-format auto|abi|bytecode|source] [-contract name] [-strict] <standard>[,<standard>...] <file>
+//	standards serve [-addr host:port] [-strict] [-fuzzy]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/unpackdev/standards"
+)
+
+// errUsage is returned by commands invoked with invalid arguments, after the command usage has been printed.
+var errUsage = errors.New("invalid usage")
+
+// command represents a single CLI subcommand.
+type command struct {
+	name        string
+	usage       string
+	description string
+	run         func(args []string, stdout, stderr io.Writer) error
+}
+
+// commands holds all supported subcommands in the order they are listed in the usage.
+var commands []command
+
+func init() {
+	// Assigned within init as command implementations refer to commands while printing their usage.
+	commands = []command{
+		{name: "list", usage: "list [flags]", description: "List registered standards", run: runList},
+		{name: "graph", usage: "graph [flags]", description: "Export the requires and extends graph of standards in DOT or JSON", run: runGraph},
+		{name: "show", usage: "show [flags] <standard>", description: "Show functions, events, errors, selectors and interface id of a standard", run: runShow},
+		{name: "detect", usage: "detect [flags] <abi.json|bytecode.hex|source.sol>", description: "Detect standards implemented by a contract, ranked by confidence", run: runDetect},
+		{name: "diff", usage: "diff [flags] <standard> <abi.json|bytecode.hex|source.sol>", description: "Compare a contract against a standard member by member", run: runDiff},
+		{name: "lint", usage: "lint [flags] <standard>[,<standard>...] <abi.json|bytecode.hex|source.sol>", description: "Check compliance of a contract with standards, failing if it does not comply", run: runLint},
+		{name: "serve", usage: "serve [flags]", description: "Serve the standards gRPC service", run: runServe},
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the CLI with the provided arguments and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return 0
+	}
+
+	if !standards.StandardsLoaded() {
+		if err := standards.LoadStandards(); err != nil {
+			fmt.Fprintf(stderr, "failure to load standards: %s\n", err)
+			return 1
+		}
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		if err := cmd.run(args[1:], stdout, stderr); err != nil {
+			switch {
+			case errors.Is(err, flag.ErrHelp):
+				return 0
+			case errors.Is(err, errUsage):
+				return 2
+			}
+			fmt.Fprintf(stderr, "standards %s: %s\n", cmd.name, err)
+			return 1
+		}
+		return 0
+	}
+
+	fmt.Fprintf(stderr, "standards: unknown command %q\n\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+// usage writes the CLI usage into the provided writer.
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: standards <command> [flags] [arguments]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(w, "\nRun 'standards <command> -h' for command flags.\n")
+}
+
+// newFlagSet creates a flag set of the command with the output format flag registered, unless the format is nil.
+func newFlagSet(name string, stderr io.Writer, format *string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	if format != nil {
+		fs.StringVar(format, "o", formatTable, "output format: table, json or proto-json")
+	}
+	fs.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				fmt.Fprintf(stderr, "Usage: standards %s\n\n%s.\n\nFlags:\n", cmd.usage, cmd.description)
+			}
+		}
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses the command flags and validates the number of positional arguments and the output format,
+// unless the format is nil.
+func parseFlags(fs *flag.FlagSet, args []string, positional int, format *string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage // Flag set has already printed the error and usage...
+	}
+
+	if fs.NArg() != positional {
+		fs.Usage()
+		return errUsage
+	}
+
+	if format == nil {
+		return nil
+	}
+	return validateFormat(*format)
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/shared"
+)
+
+func execute(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{name: "No Arguments", args: nil, code: 2, stderr: "Usage: standards"},
+		{name: "Help", args: []string{"help"}, code: 0, stdout: "Commands:"},
+		{name: "Unknown Command", args: []string{"unknown"}, code: 2, stderr: `unknown command "unknown"`},
+		{name: "Missing Argument", args: []string{"show"}, code: 2, stderr: "Usage: standards show"},
+		{name: "Unknown Flag", args: []string{"list", "-unknown"}, code: 2, stderr: "flag provided but not defined"},
+		{name: "Unknown Format", args: []string{"list", "-o", "xml"}, code: 1, stderr: `unsupported output format "xml"`},
+		{name: "Unknown Standard", args: []string{"show", "ERC0"}, code: 1, stderr: "standard not found: ERC0"},
+		{name: "Missing File", args: []string{"detect", "testdata/missing.json"}, code: 1, stderr: "failure to read contract"},
+		{name: "Unknown Confidence", args: []string{"detect", "-min", "some", "testdata/token.json"}, code: 1, stderr: `unsupported confidence level "some"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := execute(tt.args...)
+			assert.Equal(t, tt.code, code)
+			assert.Contains(t, stdout, tt.stdout)
+			assert.Contains(t, stderr, tt.stderr)
+		})
+	}
+}
+
+func TestRunList(t *testing.T) {
+	code, stdout, _ := execute("list")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `(?m)^ERC20\s+ERC-20 Token Standard\s+6\s+2\s+0$`, stdout)
+
+	code, stdout, _ = execute("list", "-o", "json")
+	assert.Equal(t, 0, code)
+	var standards []shared.ContractStandard
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &standards))
+	assert.NotEmpty(t, standards)
+
+	code, stdout, _ = execute("list", "-o", "proto-json")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `"type":\s*"ERC20"`, stdout)
+
+	code, stdout, _ = execute("list", "-category", "proxy")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "ERC1967")
+	assert.NotContains(t, stdout, "ERC20 ")
+
+	code, stdout, _ = execute("list", "-category", "proxy", "-status", "Stagnant")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "ERC1822")
+	assert.NotContains(t, stdout, "ERC1967")
+
+	code, stdout, _ = execute("list", "-event", "Transfer(address indexed from, address indexed to, uint256 value)", "-o", "json")
+	assert.Equal(t, 0, code)
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &standards))
+	assert.Len(t, standards, 2)
+
+	code, _, stderr := execute("list", "-function", "0x1234")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "invalid standards query")
+}
+
+func TestRunGraph(t *testing.T) {
+	code, stdout, _ := execute("graph")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, `"ERC2981" -> "ERC721" [label="extends", style=dashed];`)
+
+	code, stdout, _ = execute("graph", "-o", "json")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, `"kind": "requires"`)
+
+	code, _, stderr := execute("graph", "-o", "xml")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unsupported output format "xml"`)
+}
+
+func TestRunShow(t *testing.T) {
+	code, stdout, _ := execute("show", "erc20")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `Interface ID:\s+0x36372b07`, stdout)
+	assert.Regexp(t, `0xa9059cbb\s+transfer\(address,uint256\)\s+nonpayable`, stdout)
+	assert.Contains(t, stdout, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	code, stdout, _ = execute("show", "-o", "json", "OZOWNABLE")
+	assert.Equal(t, 0, code)
+	var details standardDetails
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &details))
+	assert.Equal(t, shared.Standard("OZOWNABLE"), details.Type)
+	selectors := map[string]string{}
+	for _, e := range details.Errors {
+		selectors[e.Signature] = e.Selector
+	}
+	assert.Equal(t, map[string]string{"OwnableUnauthorizedAccount(address)": "0x118cdaa7", "OwnableInvalidOwner(address)": "0x1e4fbdf7"}, selectors)
+	assert.Equal(t, "v5", details.Version)
+	assert.Equal(t, []string{"v4"}, details.Versions)
+
+	code, stdout, _ = execute("show", "-o", "proto-json", "ERC20")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `"name":\s*"ERC-20 Token Standard"`, stdout)
+}
+
+func TestRunDetect(t *testing.T) {
+	tests := []struct {
+		file       string
+		confidence shared.ConfidenceLevel
+	}{
+		{file: "testdata/token.json", confidence: shared.PerfectConfidence},
+		{file: "testdata/token.hex", confidence: shared.PerfectConfidence},
+		{file: "testdata/token.sol", confidence: shared.MediumConfidence}, // Lookalike transferFrom...
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			code, stdout, stderr := execute("detect", "-o", "json", tt.file)
+			if !assert.Equal(t, 0, code, stderr) {
+				return
+			}
+
+			var output detectOutput
+			assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+			if assert.NotEmpty(t, output.Discoveries) {
+				assert.Equal(t, shared.Standard("ERC20"), output.Discoveries[0].Standard)
+				assert.Equal(t, tt.confidence, output.Discoveries[0].Confidence)
+			}
+		})
+	}
+
+	code, stdout, _ := execute("detect", "testdata/token.json")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `(?m)^1\s+ERC20\s+`, stdout)
+	assert.NotContains(t, stdout, "ERC721")
+
+	code, stdout, _ = execute("detect", "-ambiguous", "testdata/token.json")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "ERC721")
+
+	code, stdout, _ = execute("detect", "-min", "high", "-o", "proto-json", "testdata/token.sol")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "[]", strings.TrimSpace(stdout))
+
+	code, stdout, _ = execute("detect", "-format", "source", "-contract", "Missing", "testdata/token.sol")
+	assert.Equal(t, 1, code)
+	assert.Empty(t, stdout)
+}
+
+func TestRunDiff(t *testing.T) {
+	code, stdout, _ := execute("diff", "ERC20", "testdata/token.sol")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `missing\s+function\s+transferFrom\(address,address,uint256\)`, stdout)
+	assert.Regexp(t, `extra\s+function\s+Transferfrom\(address,address,uint256\)`, stdout)
+	assert.Regexp(t, `match\s+event\s+Approval\(address,address,uint256\)`, stdout)
+
+	code, stdout, _ = execute("diff", "-fuzzy", "-o", "json", "ERC20", "testdata/token.sol")
+	assert.Equal(t, 0, code)
+	var output diffOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	statuses := map[string]string{}
+	for _, member := range output.Members {
+		statuses[member.Signature] = member.Status
+	}
+	assert.Equal(t, "match", statuses["transferFrom(address,address,uint256)"])
+	assert.NotContains(t, statuses, "Transferfrom(address,address,uint256)")
+	if assert.Len(t, output.Deviations, 1) {
+		assert.Equal(t, shared.DeviationSuspiciousLookalike, output.Deviations[0].Kind)
+	}
+
+	code, stdout, _ = execute("diff", "-o", "proto-json", "ERC20", "testdata/token.json")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `"standard":\s*"ERC20"`, stdout)
+}
+
+func TestRunLint(t *testing.T) {
+	code, stdout, stderr := execute("lint", "ERC20", "testdata/token.json")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "token: compliant with ERC20 (confidence perfect, 0 errors, 0 warnings, 3 notes)")
+
+	code, stdout, stderr = execute("lint", "ERC20", "testdata/token.sol")
+	assert.Equal(t, 1, code)
+	assert.Regexp(t, `testdata/token\.sol:\d+: error\[suspicious-lookalike\] ERC20: Transferfrom`, stdout)
+	assert.Contains(t, stderr, "is not compliant with ERC20")
+
+	code, stdout, _ = execute("lint", "-o", "sarif", "erc20,erc721", "testdata/token.json")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout, `"version": "2.1.0"`)
+	assert.Contains(t, stdout, `"ruleId": "missing-function"`)
+
+	code, _, stderr = execute("lint", "-o", "table", "ERC20", "testdata/token.json")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unsupported output format "table"`)
+}
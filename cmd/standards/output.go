@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/goccy/go-json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Supported output formats.
+const (
+	formatTable     = "table"
+	formatJSON      = "json"
+	formatProtoJSON = "proto-json"
+)
+
+// validateFormat returns an error if the provided output format is not supported.
+func validateFormat(format string) error {
+	switch format {
+	case formatTable, formatJSON, formatProtoJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, expected %s, %s or %s", format, formatTable, formatJSON, formatProtoJSON)
+	}
+}
+
+// writeJSON writes the indented JSON representation of the provided value.
+func writeJSON(w io.Writer, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failure to marshal json: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// writeProtoJSON writes the canonical protobuf JSON representation of the provided message.
+func writeProtoJSON(w io.Writer, msg proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failure to marshal proto json: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// writeProtoJSONList writes a JSON array of canonical protobuf JSON representations of the provided messages.
+func writeProtoJSONList[T proto.Message](w io.Writer, msgs []T) error {
+	items := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failure to marshal proto json: %w", err)
+		}
+		items = append(items, data)
+	}
+	return writeJSON(w, items)
+}
+
+// table writes tab separated rows as aligned columns.
+type table struct {
+	tw *tabwriter.Writer
+}
+
+// newTable creates a new table writing into the provided writer, starting with the provided header row if any.
+func newTable(w io.Writer, header ...string) *table {
+	t := &table{tw: tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)}
+	if len(header) > 0 {
+		t.row(header...)
+	}
+	return t
+}
+
+// row writes a single table row.
+func (t *table) row(columns ...string) {
+	fmt.Fprintln(t.tw, strings.Join(columns, "\t"))
+}
+
+// flush writes the buffered rows into the underlying writer.
+func (t *table) flush() error {
+	return t.tw.Flush()
+}
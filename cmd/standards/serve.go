@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os/signal"
+	"syscall"
+
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/server"
+	"google.golang.org/grpc"
+)
+
+// runServe implements the serve command, serving the standards gRPC service until interrupted.
+func runServe(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("serve", stderr, nil)
+	addr := fs.String("addr", "127.0.0.1:9090", "address to listen on")
+	strict := fs.Bool("strict", false, "score function state mutability")
+	fuzzy := fs.Bool("fuzzy", false, "match lookalike member names")
+	if err := parseFlags(fs, args, 0, nil); err != nil {
+		return err
+	}
+
+	srv, err := server.NewServer(standards.DetectorOptions{
+		Confidence: confidence.Options{Strict: *strict, Fuzzy: *fuzzy},
+	})
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return fmt.Errorf("failure to listen: %w", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	srv.Register(grpcServer)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	fmt.Fprintf(stdout, "serving %s on %s\n", server.ServiceName, listener.Addr())
+	return grpcServer.Serve(listener)
+}
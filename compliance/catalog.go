@@ -0,0 +1,57 @@
+package compliance
+
+import (
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/shared"
+)
+
+// members holds members of a standard that complement its registered definition while checking compliance.
+type members struct {
+	// functions are required or optional functions defined by the specification, but left out of the registered
+	// definition to keep detection tolerant, e.g. ERC-721 safeTransferFrom overloads.
+	functions []shared.Function
+
+	// events are required or optional events left out of the registered definition.
+	events []shared.Event
+
+	// optional holds signatures of optional members, either from the registered definition or listed above.
+	optional map[string]bool
+}
+
+// catalog holds members complementing registered definitions, keyed by the standard.
+var catalog = map[shared.Standard]members{
+	standards.ERC20: {
+		functions: []shared.Function{
+			shared.NewFunction("name", nil, []shared.Output{{Type: shared.TypeString}}),
+			shared.NewFunction("symbol", nil, []shared.Output{{Type: shared.TypeString}}),
+			shared.NewFunction("decimals", nil, []shared.Output{{Type: shared.TypeUint8}}),
+		},
+		optional: map[string]bool{"name()": true, "symbol()": true, "decimals()": true},
+	},
+	standards.ERC721: {
+		functions: []shared.Function{
+			shared.NewFunction("safeTransferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("safeTransferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("supportsInterface", []shared.Input{{Type: shared.TypeBytes4}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("tokenURI", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeString}}),
+			shared.NewFunction("tokenByIndex", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("tokenOfOwnerByIndex", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+		optional: map[string]bool{
+			// Metadata extension.
+			"name()": true, "symbol()": true, "tokenURI(uint256)": true,
+			// Enumeration extension.
+			"totalSupply()": true, "tokenByIndex(uint256)": true, "tokenOfOwnerByIndex(address,uint256)": true,
+		},
+	},
+	standards.ERC1155: {
+		functions: []shared.Function{
+			shared.NewFunction("supportsInterface", []shared.Input{{Type: shared.TypeBytes4}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("uri", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeString}}),
+		},
+		optional: map[string]bool{
+			// Metadata URI extension.
+			"uri(uint256)": true,
+		},
+	},
+}
@@ -0,0 +1,301 @@
+// Package compliance checks whether a contract complies with a standard, producing lint-style diagnostics
+// such as missing functions, wrong return types or event parameters that are not indexed, built on top of the
+// confidence check. Reports can be written as plain text or SARIF, to be consumed by CI.
+package compliance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/shared"
+	"github.com/unpackdev/standards/solidity"
+)
+
+// Severity represents the severity of a diagnostic. Values match SARIF result levels.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"   // Contract does not comply with the standard.
+	SeverityWarning Severity = "warning" // Contract complies, but likely misbehaves or misleads its users.
+	SeverityNote    Severity = "note"    // Informational, e.g. absent optional member.
+)
+
+// Rule identifiers of the reported diagnostics.
+const (
+	RuleMissingFunction   = "missing-function"
+	RuleFunctionSignature = "function-signature"
+	RuleReturnType        = "wrong-return-type"
+	RuleStateMutability   = "state-mutability"
+	RuleMissingEvent      = "missing-event"
+	RuleEventSignature    = "event-signature"
+	RuleEventIndexed      = "event-indexed"
+	RuleMissingError      = "missing-error"
+	RuleOptionalMember    = "optional-member-absent"
+	RuleLookalike         = "suspicious-lookalike"
+)
+
+// Rule describes a single compliance check.
+type Rule struct {
+	ID          string   `json:"id"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+}
+
+// Rules holds all compliance checks, in the order they are reported in.
+var Rules = []Rule{
+	{ID: RuleMissingFunction, Severity: SeverityError, Description: "Function required by the standard is not implemented."},
+	{ID: RuleFunctionSignature, Severity: SeverityError, Description: "Function is implemented with parameters that differ from the standard."},
+	{ID: RuleReturnType, Severity: SeverityError, Description: "Function returns types that differ from the standard."},
+	{ID: RuleStateMutability, Severity: SeverityWarning, Description: "Function state mutability is less restrictive than the standard allows."},
+	{ID: RuleMissingEvent, Severity: SeverityError, Description: "Event required by the standard is not declared."},
+	{ID: RuleEventSignature, Severity: SeverityError, Description: "Event is declared with parameters that differ from the standard."},
+	{ID: RuleEventIndexed, Severity: SeverityError, Description: "Event parameter indexing differs from the standard, which breaks log decoding."},
+	{ID: RuleMissingError, Severity: SeverityError, Description: "Custom error required by the standard is not declared."},
+	{ID: RuleOptionalMember, Severity: SeverityNote, Description: "Optional member of the standard is not implemented."},
+	{ID: RuleLookalike, Severity: SeverityError, Description: "Member required by the standard is missing, while a member with a resembling name is present."},
+}
+
+// Location represents the location of a diagnostic. Line is 0 if it is unknown.
+type Location struct {
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// Diagnostic represents a single finding of the compliance check.
+type Diagnostic struct {
+	Rule     string          `json:"rule"`     // Identifier of the rule, e.g. RuleMissingFunction.
+	Severity Severity        `json:"severity"` // Severity of the finding.
+	Standard shared.Standard `json:"standard"` // Standard the contract was checked against.
+	Member   string          `json:"member"`   // Signature of the standard member the finding is about.
+	Message  string          `json:"message"`  // Human readable description of the finding.
+	Location Location        `json:"location"` // Location of the finding within the checked file.
+}
+
+// Report represents the outcome of a compliance check of a single contract against a single standard.
+type Report struct {
+	Standard    shared.Standard  `json:"standard"`
+	Contract    string           `json:"contract"`
+	Discovery   shared.Discovery `json:"discovery"`
+	Diagnostics []Diagnostic     `json:"diagnostics"`
+}
+
+// Compliant returns a boolean indicating whether the report has no error diagnostics.
+func (r *Report) Compliant() bool {
+	return r.Count(SeverityError) == 0
+}
+
+// Count returns the number of diagnostics of the provided severity.
+func (r *Report) Count(severity Severity) int {
+	toReturn := 0
+	for _, diagnostic := range r.Diagnostics {
+		if diagnostic.Severity == severity {
+			toReturn++
+		}
+	}
+	return toReturn
+}
+
+// Options holds the options of the compliance check.
+type Options struct {
+	// Strict enables the state mutability check of functions.
+	Strict bool
+
+	// File is the path of the checked file, used as the location of diagnostics.
+	File string
+}
+
+// Check checks the contract against the standard and returns the compliance report.
+func Check(standard shared.EIP, contract *shared.ContractMatcher, opts Options) *Report {
+	return check(standard, contract, opts, nil)
+}
+
+// CheckSolidity checks the contract parsed out of Solidity source against the standard and returns
+// the compliance report, with diagnostics pointing to member definitions within the source.
+func CheckSolidity(standard shared.EIP, contract *solidity.Contract, opts Options) *Report {
+	return check(standard, contract.ToContractMatcher(), opts, contract)
+}
+
+// check implements Check, resolving diagnostic lines out of the source contract if provided.
+func check(standard shared.EIP, contract *shared.ContractMatcher, opts Options, source *solidity.Contract) *Report {
+	discovery, _ := confidence.ConfidenceCheckWithOptions(standard, contract, confidence.Options{Strict: opts.Strict, Fuzzy: true})
+
+	c := &checker{
+		report: &Report{
+			Standard:    standard.GetType(),
+			Contract:    contract.Name,
+			Discovery:   discovery,
+			Diagnostics: make([]Diagnostic, 0),
+		},
+		opts:       opts,
+		source:     source,
+		lookalikes: make(map[string]shared.Deviation),
+	}
+
+	for _, deviation := range discovery.Deviations {
+		switch deviation.Kind {
+		case shared.DeviationSuspiciousLookalike:
+			c.lookalikes[deviation.Expected] = deviation
+		case shared.DeviationStateMutability:
+			c.add(RuleStateMutability, SeverityWarning, deviation.Member, "function", deviation.Member,
+				"%s is %s, while the standard requires %s", deviation.Member, deviation.Actual, deviation.Expected)
+		}
+	}
+
+	extra := catalog[standard.GetType()]
+	functions := append(append([]shared.Function{}, standard.GetFunctions()...), extra.functions...)
+	events := append(append([]shared.Event{}, standard.GetEvents()...), extra.events...)
+
+	for _, fn := range functions {
+		c.checkFunction(fn, contract, extra.optional[fn.Signature()])
+	}
+	for _, event := range events {
+		c.checkEvent(event, contract, extra.optional[event.Signature()])
+	}
+	for _, e := range standard.GetErrors() {
+		c.checkError(e, contract)
+	}
+
+	return c.report
+}
+
+// checker accumulates diagnostics of a single compliance check.
+type checker struct {
+	report     *Report
+	opts       Options
+	source     *solidity.Contract
+	lookalikes map[string]shared.Deviation // Lookalike deviations keyed by the standard member signature.
+}
+
+// checkFunction checks the standard function against contract functions.
+func (c *checker) checkFunction(fn shared.Function, contract *shared.ContractMatcher, optional bool) {
+	signature := fn.Signature()
+
+	var sameName *shared.Function
+	for i, contractFn := range contract.Functions {
+		if contractFn.Name != fn.Name {
+			continue
+		}
+		if contractFn.Selector() != fn.Selector() {
+			sameName = &contract.Functions[i]
+			continue
+		}
+
+		expected, actual := outputTypes(fn.Outputs), outputTypes(contractFn.Outputs)
+		if expected != actual {
+			c.add(RuleReturnType, SeverityError, signature, "function", contractFn.Signature(),
+				"%s returns %s, while the standard requires %s", signature, actual, expected)
+		}
+		return
+	}
+
+	if deviation, found := c.lookalikes[signature]; found {
+		c.add(RuleLookalike, SeverityError, signature, "function", deviation.Member,
+			"%s only resembles %s required by the standard", deviation.Member, signature)
+		return
+	}
+
+	switch {
+	case sameName != nil:
+		c.add(RuleFunctionSignature, SeverityError, signature, "function", sameName.Signature(),
+			"%s is implemented as %s", signature, sameName.Signature())
+	case optional:
+		c.add(RuleOptionalMember, SeverityNote, signature, "", "", "optional function %s is not implemented", signature)
+	default:
+		c.add(RuleMissingFunction, SeverityError, signature, "", "", "function %s is not implemented", signature)
+	}
+}
+
+// checkEvent checks the standard event against contract events.
+func (c *checker) checkEvent(event shared.Event, contract *shared.ContractMatcher, optional bool) {
+	signature := event.Signature()
+
+	var sameName *shared.Event
+	for i, contractEvent := range contract.Events {
+		if contractEvent.Name != event.Name {
+			continue
+		}
+		if contractEvent.Topic() != event.Topic() {
+			sameName = &contract.Events[i]
+			continue
+		}
+
+		for idx, input := range event.Inputs {
+			if input.Indexed == contractEvent.Inputs[idx].Indexed {
+				continue
+			}
+
+			requirement := "must be indexed"
+			if !input.Indexed {
+				requirement = "must not be indexed"
+			}
+			c.add(RuleEventIndexed, SeverityError, signature, "event", contractEvent.Signature(),
+				"parameter %d (%s) of event %s %s", idx+1, input.CanonicalType(), signature, requirement)
+		}
+		return
+	}
+
+	if deviation, found := c.lookalikes[signature]; found {
+		c.add(RuleLookalike, SeverityError, signature, "event", deviation.Member,
+			"%s only resembles event %s required by the standard", deviation.Member, signature)
+		return
+	}
+
+	switch {
+	case sameName != nil:
+		c.add(RuleEventSignature, SeverityError, signature, "event", sameName.Signature(),
+			"event %s is declared as %s", signature, sameName.Signature())
+	case optional:
+		c.add(RuleOptionalMember, SeverityNote, signature, "", "", "optional event %s is not declared", signature)
+	default:
+		c.add(RuleMissingEvent, SeverityError, signature, "", "", "event %s is not declared", signature)
+	}
+}
+
+// checkError checks the standard custom error against contract errors.
+func (c *checker) checkError(e shared.Error, contract *shared.ContractMatcher) {
+	signature := e.Signature()
+	for _, contractError := range contract.Errors {
+		if contractError.Selector() == e.Selector() {
+			return
+		}
+	}
+
+	if deviation, found := c.lookalikes[signature]; found {
+		c.add(RuleLookalike, SeverityError, signature, "error", deviation.Member,
+			"%s only resembles error %s required by the standard", deviation.Member, signature)
+		return
+	}
+
+	c.add(RuleMissingError, SeverityError, signature, "", "", "error %s is not declared", signature)
+}
+
+// add adds a diagnostic located at the contract member of the provided kind and signature,
+// or at the contract definition if the kind is empty.
+func (c *checker) add(rule string, severity Severity, member string, kind string, signature string, format string, args ...interface{}) {
+	location := Location{File: c.opts.File}
+	if c.source != nil {
+		location.Line = c.source.Line
+		if line := c.source.MemberLine(kind, signature); kind != "" && line > 0 {
+			location.Line = line
+		}
+	}
+
+	c.report.Diagnostics = append(c.report.Diagnostics, Diagnostic{
+		Rule:     rule,
+		Severity: severity,
+		Standard: c.report.Standard,
+		Member:   member,
+		Message:  fmt.Sprintf(format, args...),
+		Location: location,
+	})
+}
+
+// outputTypes returns the parenthesized canonical output types, e.g. "(bool)".
+func outputTypes(outputs []shared.Output) string {
+	types := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		types = append(types, output.CanonicalType())
+	}
+	return "(" + strings.Join(types, ",") + ")"
+}
@@ -0,0 +1,176 @@
+package compliance
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/shared"
+	"github.com/unpackdev/standards/solidity"
+)
+
+func checkSource(t *testing.T, path string, standard shared.Standard) *Report {
+	if !standards.StandardsLoaded() {
+		assert.NoError(t, standards.LoadStandards())
+	}
+
+	eip, err := standards.GetContractByStandard(standard)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	src, err := os.ReadFile(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	contracts, err := solidity.Parse(src)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return CheckSolidity(eip, solidity.Find(contracts, ""), Options{File: path})
+}
+
+func TestCheckSolidity(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		compliant bool
+		expected  []Diagnostic
+	}{
+		{
+			name:      "Compliant",
+			path:      "testdata/token.sol",
+			compliant: true,
+			expected: []Diagnostic{
+				{Rule: RuleOptionalMember, Severity: SeverityNote, Member: "symbol()", Location: Location{Line: 16}},
+				{Rule: RuleOptionalMember, Severity: SeverityNote, Member: "decimals()", Location: Location{Line: 16}},
+			},
+		},
+		{
+			name:      "Broken",
+			path:      "testdata/broken.sol",
+			compliant: false,
+			expected: []Diagnostic{
+				{Rule: RuleReturnType, Severity: SeverityError, Member: "transfer(address,uint256)", Location: Location{Line: 15}},
+				{Rule: RuleMissingFunction, Severity: SeverityError, Member: "approve(address,uint256)", Location: Location{Line: 4}},
+				{Rule: RuleLookalike, Severity: SeverityError, Member: "transferFrom(address,address,uint256)", Location: Location{Line: 21}},
+				{Rule: RuleOptionalMember, Severity: SeverityNote, Member: "decimals()", Location: Location{Line: 4}},
+				{Rule: RuleEventIndexed, Severity: SeverityError, Member: "Transfer(address,address,uint256)", Location: Location{Line: 12}},
+				{Rule: RuleEventIndexed, Severity: SeverityError, Member: "Approval(address,address,uint256)", Location: Location{Line: 13}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := checkSource(t, tt.path, standards.ERC20)
+			assert.Equal(t, tt.compliant, report.Compliant())
+			assert.Equal(t, standards.ERC20, report.Standard)
+
+			actual := make([]Diagnostic, 0, len(report.Diagnostics))
+			for _, diagnostic := range report.Diagnostics {
+				assert.Equal(t, tt.path, diagnostic.Location.File)
+				assert.NotEmpty(t, diagnostic.Message)
+				actual = append(actual, Diagnostic{
+					Rule:     diagnostic.Rule,
+					Severity: diagnostic.Severity,
+					Member:   diagnostic.Member,
+					Location: Location{Line: diagnostic.Location.Line},
+				})
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if !standards.StandardsLoaded() {
+		assert.NoError(t, standards.LoadStandards())
+	}
+
+	eip, err := standards.GetContractByStandard(standards.ERC721)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	contract := &shared.ContractMatcher{Name: "Collection", Functions: eip.GetFunctions(), Events: eip.GetEvents()}
+	report := Check(eip, contract, Options{})
+
+	// Definition of the standard leaves out required safe transfers and interface detection.
+	assert.False(t, report.Compliant())
+	assert.Equal(t, 3, report.Count(SeverityError))
+	assert.Equal(t, 3, report.Count(SeverityNote))
+	for _, diagnostic := range report.Diagnostics {
+		assert.Equal(t, Location{}, diagnostic.Location)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	report := checkSource(t, "testdata/broken.sol", standards.ERC20)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteText(&buf, []*Report{report}))
+	assert.Contains(t, buf.String(), "testdata/broken.sol:15: error[wrong-return-type] ERC20: transfer(address,uint256) returns (), while the standard requires (bool)\n")
+	assert.Contains(t, buf.String(), "testdata/broken.sol:12: error[event-indexed] ERC20: parameter 3 (uint256) of event Transfer(address,address,uint256) must not be indexed\n")
+	assert.Contains(t, buf.String(), "BrokenToken: not compliant with ERC20 (confidence medium, 5 errors, 0 warnings, 1 notes)\n")
+}
+
+func TestWriteSARIF(t *testing.T) {
+	report := checkSource(t, "testdata/broken.sol", standards.ERC20)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteSARIF(&buf, []*Report{report}))
+
+	var doc struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				RuleIndex int    `json:"ruleIndex"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "2.1.0", doc.Version)
+	if !assert.Len(t, doc.Runs, 1) {
+		return
+	}
+
+	run := doc.Runs[0]
+	assert.Equal(t, "standards", run.Tool.Driver.Name)
+	assert.Len(t, run.Tool.Driver.Rules, len(Rules))
+	assert.Len(t, run.Results, len(report.Diagnostics))
+
+	for _, result := range run.Results {
+		assert.Equal(t, result.RuleID, run.Tool.Driver.Rules[result.RuleIndex].ID)
+		assert.Equal(t, "testdata/broken.sol", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Positive(t, result.Locations[0].PhysicalLocation.Region.StartLine)
+	}
+	assert.Equal(t, "wrong-return-type", run.Results[0].RuleID)
+	assert.Equal(t, "error", run.Results[0].Level)
+	assert.Equal(t, 15, run.Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+}
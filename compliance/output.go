@@ -0,0 +1,171 @@
+package compliance
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/goccy/go-json"
+)
+
+// SARIF document constants.
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	toolName     = "standards"
+	toolURI      = "https://github.com/unpackdev/standards"
+)
+
+// WriteText writes reports as plain text, one diagnostic per line in the "file:line: severity[rule] message"
+// form understood by most editors and CI log parsers, followed by a summary line of each report.
+func WriteText(w io.Writer, reports []*Report) error {
+	for _, report := range reports {
+		for _, diagnostic := range report.Diagnostics {
+			if _, err := fmt.Fprintf(w, "%s%s[%s] %s: %s\n", diagnostic.Location.prefix(), diagnostic.Severity, diagnostic.Rule, diagnostic.Standard, diagnostic.Message); err != nil {
+				return err
+			}
+		}
+
+		status := "compliant"
+		if !report.Compliant() {
+			status = "not compliant"
+		}
+
+		_, err := fmt.Fprintf(w, "%s: %s with %s (confidence %s, %d errors, %d warnings, %d notes)\n",
+			report.Contract, status, report.Standard, report.Discovery.Confidence,
+			report.Count(SeverityError), report.Count(SeverityWarning), report.Count(SeverityNote),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prefix returns the "file:line: " prefix of the location, omitting unknown parts.
+func (l Location) prefix() string {
+	switch {
+	case l.File != "" && l.Line > 0:
+		return fmt.Sprintf("%s:%d: ", l.File, l.Line)
+	case l.File != "":
+		return l.File + ": "
+	default:
+		return ""
+	}
+}
+
+// sarifLog represents the root of a SARIF document, limited to the properties the reports use.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string            `json:"id"`
+	ShortDescription     sarifMessage      `json:"shortDescription"`
+	DefaultConfiguration sarifRuleDefaults `json:"defaultConfiguration"`
+}
+
+type sarifRuleDefaults struct {
+	Level Severity `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	RuleIndex  int               `json:"ruleIndex"`
+	Level      Severity          `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// WriteSARIF writes reports as a SARIF 2.1.0 document with a single run, to be uploaded to code scanning.
+func WriteSARIF(w io.Writer, reports []*Report) error {
+	ruleIndexes := make(map[string]int, len(Rules))
+	rules := make([]sarifRule, 0, len(Rules))
+	for idx, rule := range Rules {
+		ruleIndexes[rule.ID] = idx
+		rules = append(rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifRuleDefaults{Level: rule.Severity},
+		})
+	}
+
+	results := make([]sarifResult, 0)
+	for _, report := range reports {
+		for _, diagnostic := range report.Diagnostics {
+			result := sarifResult{
+				RuleID:    diagnostic.Rule,
+				RuleIndex: ruleIndexes[diagnostic.Rule],
+				Level:     diagnostic.Severity,
+				Message:   sarifMessage{Text: fmt.Sprintf("%s: %s", diagnostic.Standard, diagnostic.Message)},
+				Properties: map[string]string{
+					"standard": diagnostic.Standard.String(),
+					"contract": report.Contract,
+					"member":   diagnostic.Member,
+				},
+			}
+
+			if diagnostic.Location.File != "" {
+				location := sarifLocation{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(diagnostic.Location.File)},
+				}}
+				if diagnostic.Location.Line > 0 {
+					location.PhysicalLocation.Region = &sarifRegion{StartLine: diagnostic.Location.Line}
+				}
+				result.Locations = []sarifLocation{location}
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: toolName, InformationURI: toolURI, Rules: rules}},
+			Results: results,
+		}},
+	})
+}
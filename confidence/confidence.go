@@ -21,102 +21,248 @@ func CalculateDiscoveryConfidence(totalConfidence float64) (shared.ConfidenceLev
 	}
 }
 
+// Options represents the options used to tune the confidence checks.
+type Options struct {
+	// Strict enables scoring of the function state mutability. Each standard function with known
+	// state mutability contributes one additional token that is discovered only when the contract
+	// function mutability is known and allowed by the standard. Contracts without mutability data,
+	// e.g. built out of bytecode, therefore do not reach perfect confidence in strict mode.
+	Strict bool
+
+	// Fuzzy enables matching of lookalike member names (case-insensitive or within a small edit distance)
+	// when no exact match is found. Lookalikes receive partial credit, as the name token is not discovered,
+	// and are listed in the discovery as suspicious lookalike deviations.
+	Fuzzy bool
+
+	// ScoreOnly skips building the annotated contract copy and deviations of the discovery, leaving them nil,
+	// for callers that are only interested in the confidence level and points.
+	ScoreOnly bool
+}
+
 // ConfidenceCheck checks the confidence of a contract against a standard EIP.
 func ConfidenceCheck(standard shared.EIP, contract *shared.ContractMatcher) (shared.Discovery, bool) {
+	return ConfidenceCheckWithOptions(standard, contract, Options{})
+}
+
+// ConfidenceCheckWithOptions checks the confidence of a contract against a standard EIP using provided options.
+// State mutability deviations are always listed in the discovery, while they affect the confidence only in strict mode.
+func ConfidenceCheckWithOptions(standard shared.EIP, contract *shared.ContractMatcher, opts Options) (shared.Discovery, bool) {
+	maximumTokens := standard.TokenCount()
+	if opts.Strict {
+		maximumTokens += MutabilityTokenCount(standard.GetFunctions())
+	}
+
 	toReturn := shared.Discovery{
 		Standard:         standard.GetType(),
+		Category:         standard.GetStandard().Category,
+		Version:          standard.GetStandard().Version,
 		Confidence:       shared.NoConfidence,
 		ConfidencePoints: 0,
 		Threshold:        shared.NoConfidenceThreshold,
-		MaximumTokens:    standard.TokenCount(),
+		MaximumTokens:    maximumTokens,
 		DiscoveredTokens: 0,
-		Contract: &shared.ContractMatcher{
+	}
+
+	annotate := !opts.ScoreOnly
+	if annotate {
+		toReturn.Contract = &shared.ContractMatcher{
 			Name:      contract.Name,
-			Functions: make([]shared.Function, 0),
-			Events:    make([]shared.Event, 0),
-		},
+			Functions: make([]shared.Function, 0, len(standard.GetFunctions())),
+			Events:    make([]shared.Event, 0, len(standard.GetEvents())),
+			Errors:    make([]shared.Error, 0, len(standard.GetErrors())),
+		}
+		toReturn.Deviations = make([]shared.Deviation, 0)
 	}
+
 	foundTokenCount := 0
-	discoveredFunctions := map[string]bool{}
-	discoveredEvents := map[string]bool{}
+	s := getScratch()
+	defer s.release()
+
+	var standardFunctionNames, standardEventNames, standardErrorNames map[string]bool
+	if opts.Fuzzy {
+		standardFunctionNames = functionNames(standard.GetFunctions())
+		standardEventNames = eventNames(standard.GetEvents())
+		standardErrorNames = errorNames(standard.GetErrors())
+		s.collectCandidates(contract)
+	}
 
 	for _, standardFunction := range standard.GetFunctions() {
-		contractFn := shared.Function{
-			Name:    standardFunction.Name,
-			Inputs:  make([]shared.Input, 0),
-			Outputs: make([]shared.Output, 0),
+		var contractFn *shared.Function
+		if annotate {
+			contractFn = &shared.Function{
+				Name:    standardFunction.Name,
+				Inputs:  make([]shared.Input, 0, len(standardFunction.Inputs)),
+				Outputs: make([]shared.Output, 0, len(standardFunction.Outputs)),
+			}
 		}
 
+		matched := false
 		for _, contractFunction := range contract.Functions {
-			if _, found := discoveredFunctions[contractFunction.Name]; !found {
-				if tokensFound, found := FunctionMatch(&contractFn, standardFunction, contractFunction); found {
-					discoveredFunctions[contractFunction.Name] = true
-					contractFn.Matched = true
+			if _, found := s.functions[contractFunction.Name]; !found {
+				if tokensFound, found := FunctionMatch(contractFn, standardFunction, contractFunction); found {
+					s.functions[contractFunction.Name] = true
+					matched = true
 					foundTokenCount += tokensFound
+
+					if standardFunction.StateMutability.Allows(contractFunction.StateMutability) {
+						if opts.Strict && standardFunction.StateMutability != "" && contractFunction.StateMutability != "" {
+							foundTokenCount++
+						}
+					} else if annotate {
+						toReturn.Deviations = append(toReturn.Deviations, shared.Deviation{
+							Kind:     shared.DeviationStateMutability,
+							Member:   contractFunction.Signature(),
+							Expected: standardFunction.StateMutability.String(),
+							Actual:   contractFunction.StateMutability.String(),
+						})
+					}
+				}
+			}
+		}
+
+		if !matched && opts.Fuzzy {
+			if idx := lookalikeIndex(standardFunction.Name, s.functionCandidates, s.functions, standardFunctionNames); idx >= 0 {
+				lookalike := contract.Functions[idx]
+				lookalike.Name = standardFunction.Name
+				if tokensFound, found := FunctionMatch(contractFn, standardFunction, lookalike); found {
+					s.functions[contract.Functions[idx].Name] = true
+					matched = true
+					foundTokenCount += tokensFound - 1 // Name token is not discovered for lookalikes...
+					if annotate {
+						contractFn.Name = contract.Functions[idx].Name
+						toReturn.Deviations = append(toReturn.Deviations, lookalikeDeviation(standardFunction.Signature(), contract.Functions[idx].Signature()))
+					}
 				}
 			}
 		}
 
-		if !contractFn.Matched {
-			contractFn.Matched = false
+		if !annotate {
+			continue
+		}
+
+		contractFn.Matched = matched
+		if !matched {
+			contractFn.StateMutability = standardFunction.StateMutability
 
-			if standardFunction.Inputs == nil {
-				standardFunction.Inputs = make([]shared.Input, 0)
-			} else {
+			if standardFunction.Inputs != nil {
 				contractFn.Inputs = standardFunction.Inputs
 			}
 
-			if standardFunction.Outputs == nil {
-				standardFunction.Outputs = make([]shared.Output, 0)
-			} else {
+			if standardFunction.Outputs != nil {
 				contractFn.Outputs = standardFunction.Outputs
 			}
 		}
 
-		toReturn.Contract.Functions = append(toReturn.Contract.Functions, contractFn)
+		toReturn.Contract.Functions = append(toReturn.Contract.Functions, *contractFn)
 	}
 
 	for _, event := range standard.GetEvents() {
-
-		eventFn := shared.Event{
-			Name:    event.Name,
-			Inputs:  make([]shared.Input, 0),
-			Outputs: make([]shared.Output, 0),
+		var eventFn *shared.Event
+		if annotate {
+			eventFn = &shared.Event{
+				Name:    event.Name,
+				Inputs:  make([]shared.Input, 0, len(event.Inputs)),
+				Outputs: make([]shared.Output, 0, len(event.Outputs)),
+			}
 		}
 
+		matched := false
 		for _, contractEvent := range contract.Events {
-			if _, found := discoveredEvents[contractEvent.Name]; !found {
-				if tokensFound, found := EventMatch(&eventFn, event, contractEvent); found {
-					discoveredEvents[contractEvent.Name] = true
-					eventFn.Matched = true
+			if _, found := s.events[contractEvent.Name]; !found {
+				if tokensFound, found := EventMatch(eventFn, event, contractEvent); found {
+					s.events[contractEvent.Name] = true
+					matched = true
 					foundTokenCount += tokensFound
 				}
 			}
 		}
 
-		if !eventFn.Matched {
-			eventFn.Matched = false
+		if !matched && opts.Fuzzy {
+			if idx := lookalikeIndex(event.Name, s.eventCandidates, s.events, standardEventNames); idx >= 0 {
+				lookalike := contract.Events[idx]
+				lookalike.Name = event.Name
+				if tokensFound, found := EventMatch(eventFn, event, lookalike); found {
+					s.events[contract.Events[idx].Name] = true
+					matched = true
+					foundTokenCount += tokensFound - 1 // Name token is not discovered for lookalikes...
+					if annotate {
+						eventFn.Name = contract.Events[idx].Name
+						toReturn.Deviations = append(toReturn.Deviations, lookalikeDeviation(event.Signature(), contract.Events[idx].Signature()))
+					}
+				}
+			}
+		}
+
+		if !annotate {
+			continue
+		}
 
-			if event.Inputs == nil {
-				event.Inputs = make([]shared.Input, 0)
-			} else {
+		eventFn.Matched = matched
+		if !matched {
+			if event.Inputs != nil {
 				eventFn.Inputs = event.Inputs
 			}
 
-			if event.Outputs == nil {
-				event.Outputs = make([]shared.Output, 0)
-			} else {
+			if event.Outputs != nil {
 				eventFn.Outputs = event.Outputs
 			}
 		}
 
-		toReturn.Contract.Events = append(toReturn.Contract.Events, eventFn)
+		toReturn.Contract.Events = append(toReturn.Contract.Events, *eventFn)
+	}
+
+	for _, standardError := range standard.GetErrors() {
+		var contractErr *shared.Error
+		if annotate {
+			contractErr = &shared.Error{
+				Name:   standardError.Name,
+				Inputs: make([]shared.Input, 0, len(standardError.Inputs)),
+			}
+		}
+
+		matched := false
+		for _, contractError := range contract.Errors {
+			if _, found := s.errors[contractError.Name]; !found {
+				if tokensFound, found := ErrorMatch(contractErr, standardError, contractError); found {
+					s.errors[contractError.Name] = true
+					matched = true
+					foundTokenCount += tokensFound
+				}
+			}
+		}
+
+		if !matched && opts.Fuzzy {
+			if idx := lookalikeIndex(standardError.Name, s.errorCandidates, s.errors, standardErrorNames); idx >= 0 {
+				lookalike := contract.Errors[idx]
+				lookalike.Name = standardError.Name
+				if tokensFound, found := ErrorMatch(contractErr, standardError, lookalike); found {
+					s.errors[contract.Errors[idx].Name] = true
+					matched = true
+					foundTokenCount += tokensFound - 1 // Name token is not discovered for lookalikes...
+					if annotate {
+						contractErr.Name = contract.Errors[idx].Name
+						toReturn.Deviations = append(toReturn.Deviations, lookalikeDeviation(standardError.Signature(), contract.Errors[idx].Signature()))
+					}
+				}
+			}
+		}
+
+		if !annotate {
+			continue
+		}
+
+		contractErr.Matched = matched
+		if !matched && standardError.Inputs != nil {
+			contractErr.Inputs = standardError.Inputs
+		}
+
+		toReturn.Contract.Errors = append(toReturn.Contract.Errors, *contractErr)
 	}
 
 	toReturn.DiscoveredTokens = foundTokenCount
 
 	// Calculate the total confidence based on the discovered tokens and maximum tokens
-	confidencePoints := float64(foundTokenCount) / float64(standard.TokenCount())
+	confidencePoints := float64(foundTokenCount) / float64(maximumTokens)
 	level, threshold := CalculateDiscoveryConfidence(confidencePoints)
 	toReturn.Confidence = level
 	toReturn.ConfidencePoints = confidencePoints
@@ -125,6 +271,18 @@ func ConfidenceCheck(standard shared.EIP, contract *shared.ContractMatcher) (sha
 	return toReturn, foundTokenCount > 0
 }
 
+// MutabilityTokenCount returns the number of functions with known state mutability, each accounting
+// for one additional token while performing strict confidence checks.
+func MutabilityTokenCount(functions []shared.Function) int {
+	count := 0
+	for _, fn := range functions {
+		if fn.StateMutability != "" {
+			count++
+		}
+	}
+	return count
+}
+
 // FunctionConfidenceCheck checks for function confidence against provided EIP standard
 func FunctionConfidenceCheck(standard shared.EIP, fn *shared.Function) (shared.FunctionDiscovery, bool) {
 	foundTokenCount := 0
@@ -163,38 +321,24 @@ func FunctionConfidenceCheck(standard shared.EIP, fn *shared.Function) (shared.F
 }
 
 // FunctionMatch matches a function from a contract to a standard function and returns the total token count and a boolean indicating if a match was found.
+// When newFn is nil the tokens are only counted, without annotating the matched function.
 func FunctionMatch(newFn *shared.Function, standardFunction, contractFunction shared.Function) (int, bool) {
 	totalTokenCount := 0
-	newFn.Name = contractFunction.Name
+
 	if standardFunction.Name == contractFunction.Name {
 		totalTokenCount++
-		for _, sfnInput := range standardFunction.Inputs {
-			newInput := shared.Input{Type: sfnInput.Type, Indexed: sfnInput.Indexed}
-			for _, fnInput := range contractFunction.Inputs {
-				if standardInput, matched := InputMatch(standardFunction.Inputs, fnInput); matched {
-					totalTokenCount += 2 // Counting the input match and type match...
-					if standardInput.Indexed == fnInput.Indexed {
-						totalTokenCount++
-					}
-					newInput.Matched = true
-					break
-				}
-			}
-			newFn.Inputs = append(newFn.Inputs, newInput)
-		}
 
-		for _, sfnOutput := range standardFunction.Outputs {
-			newOutput := shared.Output{Type: sfnOutput.Type}
-			for range standardFunction.Outputs {
-				for _, fnOutput := range contractFunction.Outputs {
-					if _, matched := OutputMatch(standardFunction.Outputs, fnOutput); matched {
-						totalTokenCount += 2 // Counting the input match and type match...
-					}
-					newOutput.Matched = true
-					break
-				}
-			}
-			newFn.Outputs = append(newFn.Outputs, newOutput)
+		inputs, inputTokens := matchInputs(standardFunction.Inputs, contractFunction.Inputs, true, newFn != nil)
+		totalTokenCount += inputTokens
+
+		outputs, outputTokens := matchOutputs(standardFunction.Outputs, contractFunction.Outputs, newFn != nil)
+		totalTokenCount += outputTokens
+
+		if newFn != nil {
+			newFn.Name = contractFunction.Name
+			newFn.StateMutability = contractFunction.StateMutability
+			newFn.Inputs = append(newFn.Inputs, inputs...)
+			newFn.Outputs = append(newFn.Outputs, outputs...)
 		}
 	}
 
@@ -202,49 +346,163 @@ func FunctionMatch(newFn *shared.Function, standardFunction, contractFunction sh
 }
 
 // EventMatch matches an event from a contract to a standard event and returns the total token count and a boolean indicating if a match was found.
+// When newEvent is nil the tokens are only counted, without annotating the matched event.
 func EventMatch(newEvent *shared.Event, standardEvent, event shared.Event) (int, bool) {
 	totalTokenCount := 0
 
 	if standardEvent.Name == event.Name {
 		totalTokenCount++
-		newEvent.Name = event.Name
-		for _, seInput := range standardEvent.Inputs {
-			newInput := shared.Input{Type: seInput.Type, Indexed: seInput.Indexed}
-			for _, eventInput := range event.Inputs {
-				if standardInput, matched := InputMatch(standardEvent.Inputs, eventInput); matched {
-					totalTokenCount += 2 // Counting the input match and type match...
-					if standardInput.Indexed == eventInput.Indexed {
-						totalTokenCount++
-					}
-					newInput.Matched = true
-					break
-				}
-			}
-			newEvent.Inputs = append(newEvent.Inputs, newInput)
+
+		inputs, inputTokens := matchInputs(standardEvent.Inputs, event.Inputs, true, newEvent != nil)
+		totalTokenCount += inputTokens
+
+		outputs, outputTokens := matchOutputs(standardEvent.Outputs, event.Outputs, newEvent != nil)
+		totalTokenCount += outputTokens
+
+		if newEvent != nil {
+			newEvent.Name = event.Name
+			newEvent.Inputs = append(newEvent.Inputs, inputs...)
+			newEvent.Outputs = append(newEvent.Outputs, outputs...)
 		}
+	}
 
-		for _, seOutput := range standardEvent.Outputs {
-			newOutput := shared.Output{Type: seOutput.Type}
-			for range event.Outputs {
-				for _, fnOutput := range event.Outputs {
-					if _, matched := OutputMatch(standardEvent.Outputs, fnOutput); matched {
-						totalTokenCount += 2 // Counting the input match and type match...
-					}
-					newOutput.Matched = true
-					break
-				}
-			}
-			newEvent.Outputs = append(newEvent.Outputs, newOutput)
+	return totalTokenCount, totalTokenCount > 0
+}
+
+// ErrorMatch matches a custom error from a contract to a standard error and returns the total token count and a boolean indicating if a match was found.
+// When newError is nil the tokens are only counted, without annotating the matched error.
+func ErrorMatch(newError *shared.Error, standardError, contractError shared.Error) (int, bool) {
+	totalTokenCount := 0
+
+	if standardError.Name == contractError.Name {
+		totalTokenCount++
+
+		// Error parameters are never indexed, so only their input and type tokens are counted.
+		inputs, inputTokens := matchInputs(standardError.Inputs, contractError.Inputs, false, newError != nil)
+		totalTokenCount += inputTokens
+
+		if newError != nil {
+			newError.Name = contractError.Name
+			newError.Inputs = append(newError.Inputs, inputs...)
 		}
 	}
 
 	return totalTokenCount, totalTokenCount > 0
 }
 
+// MatchInputs matches standard inputs against contract inputs and returns the annotated standard inputs together
+// with the discovered token count. Each standard input is first compared with the contract input at the same
+// position and then with any other contract input, counting the input, type (including tuple components) and
+// indexed tokens for every match.
+func MatchInputs(standardInputs, contractInputs []shared.Input) ([]shared.Input, int) {
+	return matchInputs(standardInputs, contractInputs, true, true)
+}
+
+// MatchOutputs matches standard outputs against contract outputs and returns the annotated standard outputs together
+// with the discovered token count. Each standard output is first compared with the contract output at the same
+// position and then with any other contract output, counting the output and type (including tuple components) tokens.
+func MatchOutputs(standardOutputs, contractOutputs []shared.Output) ([]shared.Output, int) {
+	return matchOutputs(standardOutputs, contractOutputs, true)
+}
+
+// matchInputs implements MatchInputs, counting the indexed tokens only when indexed is set and building the
+// annotated inputs only when annotate is set.
+func matchInputs(standardInputs, contractInputs []shared.Input, indexed, annotate bool) ([]shared.Input, int) {
+	totalTokenCount := 0
+
+	var toReturn []shared.Input
+	if annotate {
+		toReturn = make([]shared.Input, 0, len(standardInputs))
+	}
+
+	for idx, standardInput := range standardInputs {
+		matched := false
+		if contractIdx := inputIndex(contractInputs, idx, standardInput.CanonicalType()); contractIdx >= 0 {
+			totalTokenCount += 2 // Counting the input match and type match...
+			totalTokenCount += shared.ComponentsTokenCount(standardInput.Components)
+			if indexed && standardInput.Indexed == contractInputs[contractIdx].Indexed {
+				totalTokenCount++
+			}
+			matched = true
+		}
+
+		if annotate {
+			toReturn = append(toReturn, shared.Input{
+				Name:       standardInput.Name,
+				Type:       standardInput.Type,
+				Indexed:    standardInput.Indexed,
+				Components: standardInput.Components,
+				Matched:    matched,
+			})
+		}
+	}
+
+	return toReturn, totalTokenCount
+}
+
+// matchOutputs implements MatchOutputs, building the annotated outputs only when annotate is set.
+func matchOutputs(standardOutputs, contractOutputs []shared.Output, annotate bool) ([]shared.Output, int) {
+	totalTokenCount := 0
+
+	var toReturn []shared.Output
+	if annotate {
+		toReturn = make([]shared.Output, 0, len(standardOutputs))
+	}
+
+	for idx, standardOutput := range standardOutputs {
+		matched := false
+		if outputIndex(contractOutputs, idx, standardOutput.CanonicalType()) >= 0 {
+			totalTokenCount += 2 // Counting the output match and type match...
+			totalTokenCount += shared.ComponentsTokenCount(standardOutput.Components)
+			matched = true
+		}
+
+		if annotate {
+			toReturn = append(toReturn, shared.Output{
+				Type:       standardOutput.Type,
+				Components: standardOutput.Components,
+				Matched:    matched,
+			})
+		}
+	}
+
+	return toReturn, totalTokenCount
+}
+
+// inputIndex returns the index of the input with the provided canonical type, preferring the input at the
+// provided position over any other input, or -1 if there is no such input.
+func inputIndex(inputs []shared.Input, idx int, canonicalType string) int {
+	if idx < len(inputs) && inputs[idx].CanonicalType() == canonicalType {
+		return idx
+	}
+	for i := range inputs {
+		if i != idx && inputs[i].CanonicalType() == canonicalType {
+			return i
+		}
+	}
+	return -1
+}
+
+// outputIndex returns the index of the output with the provided canonical type, preferring the output at the
+// provided position over any other output, or -1 if there is no such output.
+func outputIndex(outputs []shared.Output, idx int, canonicalType string) int {
+	if idx < len(outputs) && outputs[idx].CanonicalType() == canonicalType {
+		return idx
+	}
+	for i := range outputs {
+		if i != idx && outputs[i].CanonicalType() == canonicalType {
+			return i
+		}
+	}
+	return -1
+}
+
 // InputMatch matches an input to a list of inputs and returns the matched input and a boolean indicating if a match was found.
+// Inputs are compared by their canonical types, so tuples match only when all of their components match.
 func InputMatch(inputs []shared.Input, nodeInput shared.Input) (*shared.Input, bool) {
+	nodeType := nodeInput.CanonicalType()
 	for _, input := range inputs {
-		if input.Type == nodeInput.Type {
+		if input.CanonicalType() == nodeType {
 			return &input, true
 		}
 	}
@@ -253,9 +511,11 @@ func InputMatch(inputs []shared.Input, nodeInput shared.Input) (*shared.Input, b
 }
 
 // OutputMatch matches an output to a list of outputs and returns the matched output and a boolean indicating if a match was found.
+// Outputs are compared by their canonical types, so tuples match only when all of their components match.
 func OutputMatch(outputs []shared.Output, nodeOutput shared.Output) (*shared.Output, bool) {
+	nodeType := nodeOutput.CanonicalType()
 	for _, output := range outputs {
-		if output.Type == nodeOutput.Type {
+		if output.CanonicalType() == nodeType {
 			return &output, true
 		}
 	}
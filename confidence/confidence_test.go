@@ -1,8 +1,10 @@
-package confidence
+package confidence_test
 
 import (
 	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/confidence"
 	"github.com/unpackdev/standards/shared"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,7 +13,7 @@ import (
 func TestEIPConfidenceDiscovery(t *testing.T) {
 	tests := []struct {
 		name       string
-		standard   EIP
+		standard   shared.EIP
 		outputPath string
 		contracts  []struct {
 			name                 string
@@ -29,7 +31,7 @@ func TestEIPConfidenceDiscovery(t *testing.T) {
 	}{
 		{
 			name: "Test ERC20",
-			standard: func() standards.EIP {
+			standard: func() shared.EIP {
 				standard, err := standards.GetContractByStandard(standards.ERC20)
 				assert.NoError(t, err)
 				assert.NotNil(t, standard)
@@ -203,8 +205,8 @@ func TestEIPConfidenceDiscovery(t *testing.T) {
 										newFunction("isApprovedForAll", []Input{{Type: TypeAddress}, {Type: TypeAddress}}, []Output{{Type: TypeBool}}),
 									},
 									Events: []Event{
-										newEvent("Transfer", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256}}, nil),
-										newEvent("Approval", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256}}, nil),
+										newEvent("Transfer", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256, Indexed: true}}, nil),
+										newEvent("Approval", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256, Indexed: true}}, nil),
 										newEvent("ApprovalForAll", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeBool}}, nil),
 									},
 								},
@@ -254,7 +256,7 @@ func TestEIPConfidenceDiscovery(t *testing.T) {
 									},
 									Events: []Event{
 										newEvent("TransferSingle", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256}, {Type: TypeUint256}}, nil),
-										newEvent("TransferBatch", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeAddressArray, Indexed: true}, {Type: TypeUint256Array}, {Type: TypeUint256Array}}, nil),
+										newEvent("TransferBatch", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256Array}, {Type: TypeUint256Array}}, nil),
 										newEvent("ApprovalForAll", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeBool}}, nil),
 										newEvent("URI", []Input{{Type: TypeString, Indexed: false}, {Type: TypeUint256, Indexed: true}}, nil),
 									},
@@ -501,11 +503,40 @@ func TestFunctionMatch(t *testing.T) {
 			expectedTokens: 9,
 			expectedMatch:  true,
 		},
+		{
+			// Each output is counted once, regardless of the number of standard and contract outputs.
+			name:  "Multiple outputs",
+			newFn: &shared.Function{},
+			standardFn: shared.Function{
+				Name:    "getReserves",
+				Outputs: []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}},
+			},
+			contractFn: shared.Function{
+				Name:    "getReserves",
+				Outputs: []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}},
+			},
+			expectedTokens: 7,
+			expectedMatch:  true,
+		},
+		{
+			name:  "Partially matching outputs",
+			newFn: &shared.Function{},
+			standardFn: shared.Function{
+				Name:    "getReserves",
+				Outputs: []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}},
+			},
+			contractFn: shared.Function{
+				Name:    "getReserves",
+				Outputs: []shared.Output{{Type: shared.TypeBytes32}, {Type: shared.TypeBool}},
+			},
+			expectedTokens: 3,
+			expectedMatch:  true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotTokens, gotMatch := FunctionMatch(tt.newFn, tt.standardFn, tt.contractFn)
+			gotTokens, gotMatch := confidence.FunctionMatch(tt.newFn, tt.standardFn, tt.contractFn)
 			assert.Equal(t, tt.expectedTokens, gotTokens)
 			assert.Equal(t, tt.expectedMatch, gotMatch)
 		})
@@ -560,9 +591,379 @@ func TestEventMatch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotTokens, gotMatch := EventMatch(tt.newEvent, tt.standardEvent, tt.contractEvent)
+			gotTokens, gotMatch := confidence.EventMatch(tt.newEvent, tt.standardEvent, tt.contractEvent)
 			assert.Equal(t, tt.expectedTokens, gotTokens)
 			assert.Equal(t, tt.expectedMatch, gotMatch)
 		})
 	}
 }
+
+func TestErrorMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		standardError  shared.Error
+		contractError  shared.Error
+		expectedTokens int
+		expectedMatch  bool
+	}{
+		{
+			name:           "Matching error",
+			standardError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			contractError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			expectedTokens: 3,
+			expectedMatch:  true,
+		},
+		{
+			name:           "Indexed flag is not scored",
+			standardError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			contractError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}),
+			expectedTokens: 3,
+			expectedMatch:  true,
+		},
+		{
+			name:           "Mismatching input type",
+			standardError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			contractError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeUint256}}),
+			expectedTokens: 1,
+			expectedMatch:  true,
+		},
+		{
+			name:           "Different error",
+			standardError:  shared.NewError("OwnableInvalidOwner", []shared.Input{{Type: shared.TypeAddress}}),
+			contractError:  shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			expectedTokens: 0,
+			expectedMatch:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newError := &shared.Error{}
+			gotTokens, gotMatch := confidence.ErrorMatch(newError, tt.standardError, tt.contractError)
+			assert.Equal(t, tt.expectedTokens, gotTokens)
+			assert.Equal(t, tt.expectedMatch, gotMatch)
+		})
+	}
+
+	// Maximum tokens of errors account for the same input and type tokens, so a full match is a perfect one.
+	standardError := shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}})
+	gotTokens, _ := confidence.ErrorMatch(nil, standardError, standardError)
+	assert.Equal(t, shared.TokenCount(shared.ContractStandard{Errors: []shared.Error{standardError}}), gotTokens)
+}
+
+func TestConfidenceCheckWithErrors(t *testing.T) {
+	standard, err := standards.GetContractByStandard(standards.OZOWNABLE)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, standard.GetErrors())
+
+	contract := &shared.ContractMatcher{
+		Name:      "Ownable",
+		Functions: standard.GetFunctions(),
+		Events:    standard.GetEvents(),
+		Errors:    standard.GetErrors(),
+	}
+
+	discovery, found := standard.ConfidenceCheck(contract)
+	assert.True(t, found)
+	assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Len(t, discovery.Contract.Errors, len(standard.GetErrors()))
+	for _, e := range discovery.Contract.Errors {
+		assert.True(t, e.Matched, "error %s not matched", e.Name)
+	}
+
+	// Legacy (OZ v4) contract without custom errors can no longer reach perfect confidence.
+	contract.Errors = nil
+	discovery, found = standard.ConfidenceCheck(contract)
+	assert.True(t, found)
+	assert.Equal(t, shared.MediumConfidence, discovery.Confidence)
+	assert.Less(t, discovery.DiscoveredTokens, discovery.MaximumTokens)
+}
+
+func TestConfidenceCheckStateMutability(t *testing.T) {
+	standard, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+
+	for _, fn := range standard.GetFunctions() {
+		assert.NotEmpty(t, fn.StateMutability, "function %s has no state mutability", fn.Name)
+	}
+
+	compliant := &shared.ContractMatcher{
+		Name:      "ERC20",
+		Functions: standard.GetFunctions(),
+		Events:    standard.GetEvents(),
+	}
+
+	discovery, found := confidence.ConfidenceCheckWithOptions(standard, compliant, confidence.Options{Strict: true})
+	assert.True(t, found)
+	assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Empty(t, discovery.Deviations)
+
+	// Red flags: balanceOf that modifies the state and transfer that accepts Ether.
+	functions := make([]shared.Function, 0, len(compliant.Functions))
+	for _, fn := range compliant.Functions {
+		switch fn.Name {
+		case "balanceOf":
+			fn.StateMutability = shared.StateMutabilityNonPayable
+		case "transfer":
+			fn.StateMutability = shared.StateMutabilityPayable
+		}
+		functions = append(functions, fn)
+	}
+	flagged := &shared.ContractMatcher{Name: "ERC20", Functions: functions, Events: compliant.Events}
+
+	discovery, found = confidence.ConfidenceCheck(standard, flagged)
+	assert.True(t, found)
+	assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Len(t, discovery.Deviations, 2)
+
+	discovery, found = confidence.ConfidenceCheckWithOptions(standard, flagged, confidence.Options{Strict: true})
+	assert.True(t, found)
+	assert.Equal(t, shared.HighConfidence, discovery.Confidence)
+	assert.Equal(t, discovery.MaximumTokens-2, discovery.DiscoveredTokens)
+	assert.ElementsMatch(t, []shared.Deviation{
+		{Kind: shared.DeviationStateMutability, Member: "balanceOf(address)", Expected: "view", Actual: "nonpayable"},
+		{Kind: shared.DeviationStateMutability, Member: "transfer(address,uint256)", Expected: "nonpayable", Actual: "payable"},
+	}, discovery.Deviations)
+
+	// Contracts without mutability data, e.g. out of bytecode, do not discover the mutability tokens.
+	functions = make([]shared.Function, 0, len(compliant.Functions))
+	for _, fn := range compliant.Functions {
+		fn.StateMutability = ""
+		functions = append(functions, fn)
+	}
+	unknown := &shared.ContractMatcher{Name: "ERC20", Functions: functions, Events: compliant.Events}
+
+	discovery, found = confidence.ConfidenceCheckWithOptions(standard, unknown, confidence.Options{Strict: true})
+	assert.True(t, found)
+	assert.Empty(t, discovery.Deviations)
+	assert.Equal(t, discovery.MaximumTokens-confidence.MutabilityTokenCount(standard.GetFunctions()), discovery.DiscoveredTokens)
+}
+
+func TestConfidenceCheckTuples(t *testing.T) {
+	standard, err := standards.GetContractByStandard(standards.UNISWAPV3ROUTER)
+	assert.NoError(t, err)
+
+	matcher, err := shared.NewContractMatcherFromABI("SwapRouter", []byte(standard.GetABI()))
+	assert.NoError(t, err)
+
+	discovery, found := standard.ConfidenceCheck(matcher)
+	assert.True(t, found)
+	assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Equal(t, discovery.MaximumTokens, discovery.DiscoveredTokens)
+
+	// Tuple with a single diverging component no longer matches the standard parameter.
+	for i, fn := range matcher.Functions {
+		if fn.Name == "exactInputSingle" {
+			params := fn.Inputs[0]
+			params.Components = append([]shared.Input{}, params.Components...)
+			params.Components[2].Type = shared.TypeUint256
+			matcher.Functions[i].Inputs = []shared.Input{params}
+		}
+	}
+
+	discovery, found = standard.ConfidenceCheck(matcher)
+	assert.True(t, found)
+	assert.NotEqual(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Less(t, discovery.DiscoveredTokens, discovery.MaximumTokens)
+	for _, fn := range discovery.Contract.Functions {
+		if fn.Name == "exactInputSingle" {
+			assert.True(t, fn.Matched)
+			assert.False(t, fn.Inputs[0].Matched)
+		}
+	}
+}
+
+func TestConfidenceCheckNormalizedTypes(t *testing.T) {
+	standard, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+
+	// Types as they come out of source code rather than the ABI.
+	contract := &shared.ContractMatcher{
+		Name: "ERC20 Source Types",
+		Functions: []shared.Function{
+			shared.NewFunction("totalSupply", nil, []shared.Output{{Type: "uint"}}),
+			shared.NewFunction("balanceOf", []shared.Input{{Type: "address"}}, []shared.Output{{Type: "uint"}}),
+			shared.NewFunction("transfer", []shared.Input{{Type: "address payable"}, {Type: "uint"}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("transferFrom", []shared.Input{{Type: "address"}, {Type: "address payable"}, {Type: "uint"}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("approve", []shared.Input{{Type: "contract ISpender"}, {Type: "uint"}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("allowance", []shared.Input{{Type: "address"}, {Type: "address"}}, []shared.Output{{Type: "uint"}}),
+		},
+		Events: standard.GetEvents(),
+	}
+
+	discovery, found := standard.ConfidenceCheck(contract)
+	assert.True(t, found)
+	assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+	assert.Equal(t, discovery.MaximumTokens, discovery.DiscoveredTokens)
+}
+
+func TestConfidenceCheckFuzzy(t *testing.T) {
+	standard, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+
+	// Lookalike names commonly found in obfuscated and scam tokens.
+	contract := &shared.ContractMatcher{
+		Name: "ERC20 Lookalike",
+		Functions: []shared.Function{
+			shared.NewFunction("totalSupply", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("transfer_", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("tranfserFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("Approve", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("allowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+		Events: standard.GetEvents(),
+	}
+
+	exact, _ := standard.ConfidenceCheck(contract)
+	assert.Empty(t, exact.Deviations)
+
+	fuzzy, found := confidence.ConfidenceCheckWithOptions(standard, contract, confidence.Options{Fuzzy: true})
+	assert.True(t, found)
+	assert.Greater(t, fuzzy.DiscoveredTokens, exact.DiscoveredTokens)
+	assert.Less(t, fuzzy.DiscoveredTokens, fuzzy.MaximumTokens)
+	assert.Equal(t, shared.HighConfidence, fuzzy.Confidence)
+
+	lookalikes := map[string]string{}
+	for _, deviation := range fuzzy.Deviations {
+		assert.Equal(t, shared.DeviationSuspiciousLookalike, deviation.Kind)
+		lookalikes[deviation.Expected] = deviation.Actual
+	}
+	assert.Equal(t, map[string]string{
+		"transfer(address,uint256)":             "transfer_(address,uint256)",
+		"transferFrom(address,address,uint256)": "tranfserFrom(address,address,uint256)",
+		"approve(address,uint256)":              "Approve(address,uint256)",
+	}, lookalikes)
+
+	for _, fn := range fuzzy.Contract.Functions {
+		assert.True(t, fn.Matched, fn.Name)
+	}
+}
+
+func TestIsLookalike(t *testing.T) {
+	tests := []struct {
+		expected string
+		actual   string
+		want     bool
+	}{
+		{"transfer", "transfer", false},
+		{"transfer", "Transfer", true},
+		{"transfer", "transfer_", true},
+		{"transfer", "tranfser", true},
+		{"approve", "aprove", true},
+		{"approve", "apprve_x", false},
+		{"name", "nam", true},
+		{"uri", "url", false},
+		{"uri", "URI", true},
+		{"owner", "power", false},
+		{"balanceOf", "balanceOfBatch", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected+"/"+tt.actual, func(t *testing.T) {
+			assert.Equal(t, tt.want, confidence.IsLookalike(tt.expected, tt.actual))
+		})
+	}
+
+	assert.Equal(t, 1, confidence.EditDistance("transfer", "tranfser"))
+	assert.Equal(t, 3, confidence.EditDistance("kitten", "sitting"))
+	assert.Equal(t, 0, confidence.EditDistance("", ""))
+}
+
+func TestConfidenceCheckScoreOnly(t *testing.T) {
+	for _, standard := range []shared.Standard{standards.ERC20, standards.ERC721, standards.OZOWNABLE, standards.UNISWAPV3ROUTER} {
+		t.Run(standard.String(), func(t *testing.T) {
+			eip, err := standards.GetContractByStandard(standard)
+			assert.NoError(t, err)
+
+			// Partial contract with a single function, so the scores are not trivially perfect.
+			contract := &shared.ContractMatcher{
+				Name:      eip.GetName(),
+				Functions: eip.GetFunctions()[:1],
+				Events:    eip.GetEvents(),
+			}
+
+			for _, opts := range []confidence.Options{{}, {Strict: true}, {Fuzzy: true}} {
+				annotated, annotatedFound := confidence.ConfidenceCheckWithOptions(eip, contract, opts)
+				opts.ScoreOnly = true
+				scored, scoredFound := confidence.ConfidenceCheckWithOptions(eip, contract, opts)
+
+				assert.Equal(t, annotatedFound, scoredFound)
+				assert.Equal(t, annotated.Confidence, scored.Confidence)
+				assert.Equal(t, annotated.ConfidencePoints, scored.ConfidencePoints)
+				assert.Equal(t, annotated.DiscoveredTokens, scored.DiscoveredTokens)
+				assert.Equal(t, annotated.MaximumTokens, scored.MaximumTokens)
+				assert.NotNil(t, annotated.Contract)
+				assert.Nil(t, scored.Contract)
+				assert.Nil(t, scored.Deviations)
+			}
+		})
+	}
+}
+
+func BenchmarkConfidenceCheck(b *testing.B) {
+	for _, standard := range []shared.Standard{standards.ERC20, standards.ERC1155, standards.UNISWAPV3POSITIONMANAGER} {
+		eip, err := standards.GetContractByStandard(standard)
+		assert.NoError(b, err)
+
+		contract := &shared.ContractMatcher{
+			Name:      eip.GetName(),
+			Functions: eip.GetFunctions(),
+			Events:    eip.GetEvents(),
+			Errors:    eip.GetErrors(),
+		}
+
+		b.Run(standard.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = confidence.ConfidenceCheck(eip, contract)
+			}
+		})
+
+		b.Run(standard.String()+"/ScoreOnly", func(b *testing.B) {
+			opts := confidence.Options{ScoreOnly: true}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = confidence.ConfidenceCheckWithOptions(eip, contract, opts)
+			}
+		})
+
+		// Lookalike function names are only matched by the fuzzy path.
+		lookalikes := *contract
+		lookalikes.Functions = make([]shared.Function, 0, len(contract.Functions))
+		for _, fn := range contract.Functions {
+			fn.Name = strings.ToUpper(fn.Name)
+			lookalikes.Functions = append(lookalikes.Functions, fn)
+		}
+
+		b.Run(standard.String()+"/Fuzzy", func(b *testing.B) {
+			opts := confidence.Options{Fuzzy: true}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = confidence.ConfidenceCheckWithOptions(eip, &lookalikes, opts)
+			}
+		})
+	}
+}
+
+func BenchmarkFunctionMatch(b *testing.B) {
+	standard, err := standards.GetContractByStandard(standards.UNISWAPV3ROUTER)
+	assert.NoError(b, err)
+
+	fn := standard.GetFunctions()[0]
+
+	b.Run("Annotated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			newFn := shared.Function{}
+			_, _ = confidence.FunctionMatch(&newFn, fn, fn)
+		}
+	})
+
+	b.Run("ScoreOnly", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = confidence.FunctionMatch(nil, fn, fn)
+		}
+	})
+}
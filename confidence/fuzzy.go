@@ -0,0 +1,122 @@
+package confidence
+
+import (
+	"strings"
+
+	"github.com/unpackdev/standards/shared"
+)
+
+// EditDistance calculates the optimal string alignment distance between two strings, that is the minimum
+// number of insertions, deletions, substitutions and transpositions of adjacent characters required to
+// transform one string into the other.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	rows, cols := len(ra)+1, len(rb)+1
+
+	d := make([][]int, rows)
+	for i := range d {
+		d[i] = make([]int, cols)
+		d[i][0] = i
+	}
+	for j := 0; j < cols; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i < rows; i++ {
+		for j := 1; j < cols; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[rows-1][cols-1]
+}
+
+// IsLookalike reports whether the actual member name resembles, without being equal to, the expected name.
+// Names that differ only in casing are always lookalikes, while the allowed edit distance grows with the
+// name length: none for names up to 3 characters, one up to 7 characters and two for longer names.
+func IsLookalike(expected, actual string) bool {
+	if expected == actual || expected == "" || actual == "" {
+		return false
+	}
+
+	if strings.EqualFold(expected, actual) {
+		return true
+	}
+
+	maxDistance := 2
+	switch length := len(expected); {
+	case length <= 3:
+		return false
+	case length <= 7:
+		maxDistance = 1
+	}
+
+	// Edit distance is at least the difference of the name lengths, so distant names need not be aligned.
+	if diff := len(expected) - len(actual); diff > maxDistance || -diff > maxDistance {
+		return false
+	}
+
+	return EditDistance(strings.ToLower(expected), strings.ToLower(actual)) <= maxDistance
+}
+
+// lookalikeIndex returns the index of the candidate name that best resembles the provided name, or -1 if none does.
+// Candidates that were already discovered or that exactly match a standard member name are never considered.
+func lookalikeIndex(name string, candidates []string, discovered map[string]bool, standardNames map[string]bool) int {
+	bestIdx, bestDistance := -1, 0
+	for idx, candidate := range candidates {
+		if discovered[candidate] || standardNames[candidate] || !IsLookalike(name, candidate) {
+			continue
+		}
+
+		distance := EditDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if bestIdx < 0 || distance < bestDistance {
+			bestIdx, bestDistance = idx, distance
+		}
+	}
+	return bestIdx
+}
+
+// lookalikeDeviation creates a suspicious lookalike deviation out of the expected and actual member signatures.
+func lookalikeDeviation(expected, actual string) shared.Deviation {
+	return shared.Deviation{
+		Kind:     shared.DeviationSuspiciousLookalike,
+		Member:   actual,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// functionNames returns a set of the provided function names.
+func functionNames(functions []shared.Function) map[string]bool {
+	toReturn := make(map[string]bool, len(functions))
+	for _, fn := range functions {
+		toReturn[fn.Name] = true
+	}
+	return toReturn
+}
+
+// eventNames returns a set of the provided event names.
+func eventNames(events []shared.Event) map[string]bool {
+	toReturn := make(map[string]bool, len(events))
+	for _, event := range events {
+		toReturn[event.Name] = true
+	}
+	return toReturn
+}
+
+// errorNames returns a set of the provided error names.
+func errorNames(errors []shared.Error) map[string]bool {
+	toReturn := make(map[string]bool, len(errors))
+	for _, e := range errors {
+		toReturn[e.Name] = true
+	}
+	return toReturn
+}
@@ -0,0 +1,58 @@
+package confidence
+
+import (
+	"sync"
+
+	"github.com/unpackdev/standards/shared"
+)
+
+// scratch holds buffers that are reused across confidence checks to avoid allocating them on every check.
+type scratch struct {
+	functions map[string]bool // Discovered contract function names.
+	events    map[string]bool // Discovered contract event names.
+	errors    map[string]bool // Discovered contract error names.
+
+	functionCandidates []string // Contract function names, in order, matched against lookalikes.
+	eventCandidates    []string // Contract event names, in order, matched against lookalikes.
+	errorCandidates    []string // Contract error names, in order, matched against lookalikes.
+}
+
+// scratchPool is a pool of scratch buffers shared by concurrent confidence checks.
+var scratchPool = sync.Pool{
+	New: func() any {
+		return &scratch{
+			functions: make(map[string]bool),
+			events:    make(map[string]bool),
+			errors:    make(map[string]bool),
+		}
+	},
+}
+
+// getScratch retrieves a scratch buffer from the pool. It must be returned by calling release once it is no longer used.
+func getScratch() *scratch {
+	return scratchPool.Get().(*scratch)
+}
+
+// release resets the scratch buffer and returns it to the pool.
+func (s *scratch) release() {
+	clear(s.functions)
+	clear(s.events)
+	clear(s.errors)
+	s.functionCandidates = s.functionCandidates[:0]
+	s.eventCandidates = s.eventCandidates[:0]
+	s.errorCandidates = s.errorCandidates[:0]
+	scratchPool.Put(s)
+}
+
+// collectCandidates collects the contract member names matched against lookalikes, once per confidence check.
+func (s *scratch) collectCandidates(contract *shared.ContractMatcher) {
+	for _, fn := range contract.Functions {
+		s.functionCandidates = append(s.functionCandidates, fn.Name)
+	}
+	for _, event := range contract.Events {
+		s.eventCandidates = append(s.eventCandidates, event.Name)
+	}
+	for _, e := range contract.Errors {
+		s.errorCandidates = append(s.errorCandidates, e.Name)
+	}
+}
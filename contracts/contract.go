@@ -37,6 +37,11 @@ func (e *Contract) GetEvents() []shared.Event {
 	return e.Standard.Events
 }
 
+// GetErrors returns the custom errors associated with the standard.
+func (e *Contract) GetErrors() []shared.Error {
+	return e.Standard.Errors
+}
+
 // GetStandard returns the complete contract standard.
 func (e *Contract) GetStandard() shared.ContractStandard {
 	return e.Standard
@@ -44,7 +49,7 @@ func (e *Contract) GetStandard() shared.ContractStandard {
 
 // IsStagnant returns a boolean indicating whether the standard is stagnant.
 func (e *Contract) IsStagnant() bool {
-	return e.Standard.Stagnant
+	return e.Standard.Status == shared.StatusStagnant
 }
 
 // ConfidenceCheck performs a general confidence check of the contract standard against a provided contract matcher.
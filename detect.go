@@ -0,0 +1,220 @@
+package standards
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// DetectorOptions holds the options used while detecting standards of contracts.
+type DetectorOptions struct {
+	// Workers is the number of concurrent workers used by batch detection. Defaults to runtime.NumCPU().
+	Workers int
+
+	// Confidence holds the options passed to the confidence check of each standard.
+	Confidence confidence.Options
+
+	// KeepAmbiguous skips the disambiguation stage, keeping discoveries of mutually exclusive standards such as
+	// ERC-20 and ERC-721 both, see Disambiguate.
+	KeepAmbiguous bool
+}
+
+// DetectionResult holds the outcome of standard detection for a single contract within a batch.
+type DetectionResult struct {
+	Index       int                     `json:"index"`       // Position of the contract within the batch input.
+	Contract    *shared.ContractMatcher `json:"contract"`    // Contract the detection was performed on.
+	Discoveries []shared.Discovery      `json:"discoveries"` // Discovered standards, ordered by confidence.
+	Err         error                   `json:"-"`           // Error encountered while detecting the contract standards.
+}
+
+// ContractIterator returns the next contract to be detected, or io.EOF once there are no more contracts.
+type ContractIterator func() (*shared.ContractMatcher, error)
+
+// Detector detects which of the registered standards a contract implements. Each contract is only checked
+// against the standards it shares at least one function, event or error with, as looked up in the registry
+// inverted index (see GetCandidateStandards), instead of against every registered standard.
+type Detector struct {
+	opts DetectorOptions
+	eips []shared.EIP
+}
+
+// NewDetector creates a new Detector out of the currently registered standards.
+// It returns an error if no standards are registered, see LoadStandards.
+func NewDetector(opts DetectorOptions) (*Detector, error) {
+	eips := GetSortedRegisteredStandards()
+	if len(eips) == 0 {
+		return nil, errors.ErrNoStandardsRegistered
+	}
+
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+
+	return &Detector{opts: opts, eips: eips}, nil
+}
+
+// Detect checks the contract against all candidate standards and returns discoveries of the matched ones,
+// ordered by confidence points in descending order. Standards with superseded versions are reported with the
+// best matching version, see GetVersions. Discoveries of mutually exclusive standards are resolved down to the
+// one the contract implements, unless KeepAmbiguous is set.
+func (d *Detector) Detect(contract *shared.ContractMatcher) ([]shared.Discovery, error) {
+	if contract == nil {
+		return nil, errors.ErrInvalidContract
+	}
+
+	toReturn := make([]shared.Discovery, 0)
+	for _, eip := range d.candidates(contract) {
+		if discovery, found := confidence.ConfidenceCheckWithOptions(eip, contract, d.opts.Confidence); found {
+			toReturn = append(toReturn, resolveVersion(eip.GetType(), contract, discovery, d.opts.Confidence))
+		}
+	}
+
+	sort.SliceStable(toReturn, func(i, j int) bool {
+		return toReturn[i].ConfidencePoints > toReturn[j].ConfidencePoints
+	})
+
+	if !d.opts.KeepAmbiguous {
+		toReturn = Disambiguate(contract, toReturn)
+	}
+
+	return toReturn, nil
+}
+
+// DetectBatch detects standards of contracts received from the provided channel across the configured number
+// of workers and streams results back as they are completed, so results are not ordered by their input index.
+// The returned channel is closed once the input channel is closed and drained, or once the context is done.
+func (d *Detector) DetectBatch(ctx context.Context, contracts <-chan *shared.ContractMatcher) <-chan DetectionResult {
+	type job struct {
+		index    int
+		contract *shared.ContractMatcher
+	}
+
+	jobs := make(chan job)
+	results := make(chan DetectionResult, d.opts.Workers)
+
+	go func() {
+		defer close(jobs)
+		for index := 0; ; index++ {
+			select {
+			case <-ctx.Done():
+				return
+			case contract, ok := <-contracts:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case jobs <- job{index: index, contract: contract}:
+				}
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(d.opts.Workers)
+	for w := 0; w < d.opts.Workers; w++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				result := d.detect(j.index, j.contract)
+				select {
+				case <-ctx.Done():
+					return
+				case results <- result:
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// DetectIterator detects standards of contracts returned by the provided iterator, see DetectBatch.
+// An iterator error other than io.EOF is streamed back as a result error and stops the iteration.
+func (d *Detector) DetectIterator(ctx context.Context, next ContractIterator) <-chan DetectionResult {
+	contracts := make(chan *shared.ContractMatcher)
+	iteratorErr := make(chan error, 1)
+
+	go func() {
+		defer close(contracts)
+		for {
+			contract, err := next()
+			if err != nil {
+				if err != io.EOF {
+					iteratorErr <- err
+				}
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case contracts <- contract:
+			}
+		}
+	}()
+
+	toReturn := make(chan DetectionResult)
+	go func() {
+		defer close(toReturn)
+
+		index := 0
+		for result := range d.DetectBatch(ctx, contracts) {
+			index++
+			select {
+			case <-ctx.Done():
+				return
+			case toReturn <- result:
+			}
+		}
+
+		select {
+		case err := <-iteratorErr:
+			select {
+			case <-ctx.Done():
+			case toReturn <- DetectionResult{Index: index, Err: fmt.Errorf("failure to iterate contracts: %w", err)}:
+			}
+		default:
+		}
+	}()
+
+	return toReturn
+}
+
+// detect performs detection of a single batch contract, converting panics into per-item errors so a single
+// malformed contract cannot take down the whole batch.
+func (d *Detector) detect(index int, contract *shared.ContractMatcher) (result DetectionResult) {
+	result = DetectionResult{Index: index, Contract: contract}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result.Discoveries = nil
+			result.Err = fmt.Errorf("failure to detect contract standards: %v", r)
+		}
+	}()
+
+	result.Discoveries, result.Err = d.Detect(contract)
+	return result
+}
+
+// candidates returns the standards the contract is checked against. Fuzzy matching tolerates differing names
+// and therefore selectors, so every standard is a candidate when it is enabled.
+func (d *Detector) candidates(contract *shared.ContractMatcher) []shared.EIP {
+	if d.opts.Confidence.Fuzzy {
+		return d.eips
+	}
+	return GetCandidateStandards(contract)
+}
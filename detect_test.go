@@ -0,0 +1,437 @@
+package standards
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// loadStandards registers all standards for the duration of the test, restoring the empty registry afterwards
+// so the storage tests remain independent of test ordering.
+func loadStandards(t testing.TB) {
+	if StandardsLoaded() {
+		return
+	}
+
+	assert.NoError(t, LoadStandards())
+	t.Cleanup(reset)
+}
+
+func standardMatcher(t testing.TB, standard shared.Standard) *shared.ContractMatcher {
+	eip, err := GetContractByStandard(standard)
+	assert.NoError(t, err)
+
+	return &shared.ContractMatcher{
+		Name:      eip.GetName(),
+		Functions: eip.GetFunctions(),
+		Events:    eip.GetEvents(),
+		Errors:    eip.GetErrors(),
+	}
+}
+
+func TestDetectorDetect(t *testing.T) {
+	loadStandards(t)
+
+	// Ambiguous discoveries are kept, as disambiguation is covered by TestDetectorDisambiguate.
+	detector, err := NewDetector(DetectorOptions{KeepAmbiguous: true})
+	assert.NoError(t, err)
+
+	_, err = detector.Detect(nil)
+	assert.ErrorIs(t, err, errors.ErrInvalidContract)
+
+	for _, standard := range []shared.Standard{ERC20, ERC721, ERC1155, OZOWNABLE, UNISWAPV3POOL} {
+		t.Run(standard.String(), func(t *testing.T) {
+			contract := standardMatcher(t, standard)
+
+			discoveries, err := detector.Detect(contract)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, discoveries)
+			assert.Equal(t, shared.PerfectConfidence, discoveries[0].Confidence)
+
+			// Indexed detection must find exactly what a check against every registered standard finds, among
+			// standards sharing a member signature with the contract. A full scan additionally finds standards
+			// sharing only member names, e.g. ERC-1155 balanceOf(address,uint256) for ERC-20 balanceOf(address),
+			// which the selector index does not return. These only score name tokens, so they are expected to
+			// remain at no confidence.
+			candidates := map[shared.Standard]bool{}
+			for _, eip := range GetCandidateStandards(contract) {
+				candidates[eip.GetType()] = true
+			}
+
+			expected := map[shared.Standard]shared.ConfidenceLevel{}
+			for _, eip := range GetRegisteredStandards() {
+				discovery, found := eip.ConfidenceCheck(contract)
+				if !found {
+					continue
+				}
+				if !candidates[eip.GetType()] {
+					assert.Equal(t, shared.NoConfidence, discovery.Confidence, "%s matched by member names only", eip.GetType())
+					continue
+				}
+				expected[eip.GetType()] = resolveVersion(eip.GetType(), contract, discovery, confidence.Options{}).Confidence
+			}
+
+			detected := map[shared.Standard]shared.ConfidenceLevel{}
+			for i, discovery := range discoveries {
+				detected[discovery.Standard] = discovery.Confidence
+				if i > 0 {
+					assert.GreaterOrEqual(t, discoveries[i-1].ConfidencePoints, discovery.ConfidencePoints)
+				}
+			}
+			assert.Equal(t, expected, detected)
+			assert.Contains(t, detected, standard)
+		})
+	}
+}
+
+func TestDetectorFuzzy(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{Confidence: confidence.Options{Fuzzy: true}})
+	assert.NoError(t, err)
+
+	contract := &shared.ContractMatcher{
+		Name: "Lookalike",
+		Functions: []shared.Function{
+			shared.NewFunction("transfer_", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+		},
+	}
+
+	discoveries, err := detector.Detect(contract)
+	assert.NoError(t, err)
+
+	detected := map[shared.Standard]bool{}
+	for _, discovery := range discoveries {
+		detected[discovery.Standard] = true
+	}
+	assert.True(t, detected[ERC20])
+}
+
+func TestDetectorDetectBatch(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{Workers: 4})
+	assert.NoError(t, err)
+
+	standards := []shared.Standard{ERC20, ERC721, ERC1155, OZOWNABLE}
+	contracts := make(chan *shared.ContractMatcher)
+	go func() {
+		defer close(contracts)
+		for i := 0; i < 100; i++ {
+			if i == 50 {
+				contracts <- nil
+				continue
+			}
+			contracts <- standardMatcher(t, standards[i%len(standards)])
+		}
+	}()
+
+	seen := map[int]bool{}
+	for result := range detector.DetectBatch(context.Background(), contracts) {
+		assert.False(t, seen[result.Index])
+		seen[result.Index] = true
+
+		if result.Index == 50 {
+			assert.ErrorIs(t, result.Err, errors.ErrInvalidContract)
+			continue
+		}
+
+		assert.NoError(t, result.Err)
+		assert.NotEmpty(t, result.Discoveries)
+		assert.Equal(t, standards[result.Index%len(standards)], result.Discoveries[0].Standard)
+	}
+	assert.Len(t, seen, 100)
+}
+
+func TestDetectorDetectBatchCancel(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{Workers: 2})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Contracts channel is never closed, so results can only be closed through cancellation.
+	contracts := make(chan *shared.ContractMatcher)
+	results := detector.DetectBatch(ctx, contracts)
+
+	contracts <- standardMatcher(t, ERC20)
+	cancel()
+
+	for range results {
+	}
+}
+
+func TestDetectorDetectIterator(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{Workers: 2})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		err     error
+		results int
+		errors  int
+	}{
+		{name: "EOF", err: io.EOF, results: 10, errors: 0},
+		{name: "Failure", err: fmt.Errorf("corrupted dump"), results: 11, errors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 0
+			next := func() (*shared.ContractMatcher, error) {
+				if count == 10 {
+					return nil, tt.err
+				}
+				count++
+				return standardMatcher(t, ERC20), nil
+			}
+
+			results, errs := 0, 0
+			for result := range detector.DetectIterator(context.Background(), next) {
+				results++
+				if result.Err != nil {
+					errs++
+					assert.Nil(t, result.Contract)
+				}
+			}
+			assert.Equal(t, tt.results, results)
+			assert.Equal(t, tt.errors, errs)
+		})
+	}
+}
+
+func BenchmarkDetect(b *testing.B) {
+	loadStandards(b)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(b, err)
+
+	contract := standardMatcher(b, ERC20)
+
+	b.Run("Indexed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = detector.Detect(contract)
+		}
+	})
+
+	b.Run("FullScan", func(b *testing.B) {
+		eips := GetSortedRegisteredStandards()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, eip := range eips {
+				_, _ = eip.ConfidenceCheck(contract)
+			}
+		}
+	})
+}
+
+func BenchmarkGetCandidateStandards(b *testing.B) {
+	loadStandards(b)
+
+	contract := standardMatcher(b, UNISWAPV3POOL)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = GetCandidateStandards(contract)
+	}
+}
+
+func TestDetectorDetectLendingMarkets(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		contract *shared.ContractMatcher
+		expected shared.Standard
+		other    shared.Standard // Related standard that must not be matched perfectly.
+	}{
+		{
+			name:     "cToken",
+			contract: mergeMatchers("cToken", standardMatcher(t, COMPOUNDV2CTOKEN), standardMatcher(t, ERC20)),
+			expected: COMPOUNDV2CTOKEN,
+			other:    COMPOUNDV3COMET,
+		},
+		{name: "AaveV2", contract: standardMatcher(t, AAVEV2POOL), expected: AAVEV2POOL, other: AAVEV3POOL},
+		{name: "AaveV3", contract: standardMatcher(t, AAVEV3POOL), expected: AAVEV3POOL, other: AAVEV2POOL},
+		{name: "Comet", contract: standardMatcher(t, COMPOUNDV3COMET), expected: COMPOUNDV3COMET, other: AAVEV3POOL},
+		{name: "FlashLender", contract: standardMatcher(t, ERC3156), expected: ERC3156, other: ERC3156BORROWER},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discoveries, err := detector.Detect(tt.contract)
+			assert.NoError(t, err)
+
+			levels := make(map[shared.Standard]shared.ConfidenceLevel)
+			for _, discovery := range discoveries {
+				levels[discovery.Standard] = discovery.Confidence
+			}
+			assert.Equal(t, shared.PerfectConfidence, levels[tt.expected])
+			assert.Less(t, levels[tt.other], shared.HighConfidence)
+		})
+	}
+}
+
+func TestDetectorDetectAaveV3Pool(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	// Core of the deployed Aave V3 Pool ABI, which shares withdraw, borrow and repay with the V2 lending pool.
+	contract, err := shared.NewContractMatcherFromABI("Pool", []byte(`[`+
+		`{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"supply","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"to","type":"address"}],"name":"withdraw","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"},{"internalType":"uint16","name":"referralCode","type":"uint16"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"borrow","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"repay","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"receiverAddress","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"params","type":"bytes"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"flashLoanSimple","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"user","type":"address"}],"name":"getUserAccountData","outputs":[{"internalType":"uint256","name":"totalCollateralBase","type":"uint256"},{"internalType":"uint256","name":"totalDebtBase","type":"uint256"},{"internalType":"uint256","name":"availableBorrowsBase","type":"uint256"},{"internalType":"uint256","name":"currentLiquidationThreshold","type":"uint256"},{"internalType":"uint256","name":"ltv","type":"uint256"},{"internalType":"uint256","name":"healthFactor","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"Supply","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Withdraw","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"enum DataTypes.InterestRateMode","name":"interestRateMode","type":"uint8"},{"indexed":false,"internalType":"uint256","name":"borrowRate","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"Borrow","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"repayer","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"bool","name":"useATokens","type":"bool"}],"name":"Repay","type":"event"}]`))
+	assert.NoError(t, err)
+
+	discoveries, err := detector.Detect(contract)
+	assert.NoError(t, err)
+
+	points := make(map[shared.Standard]float64)
+	for _, discovery := range discoveries {
+		points[discovery.Standard] = discovery.ConfidencePoints
+	}
+	assert.Contains(t, points, AAVEV3POOL)
+	assert.Greater(t, points[AAVEV3POOL], points[AAVEV2POOL])
+	assert.Greater(t, points[AAVEV3POOL], points[COMPOUNDV3COMET])
+}
+
+func TestDetectorDetectProxy(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	// ABI of the OpenZeppelin v5 ERC1967Proxy, which only exposes the ERC-1967 events and errors.
+	contract, err := shared.NewContractMatcherFromABI("ERC1967Proxy", []byte(`[{"inputs":[{"internalType":"address","name":"implementation","type":"address"},{"internalType":"bytes","name":"_data","type":"bytes"}],"stateMutability":"payable","type":"constructor"},{"inputs":[{"internalType":"address","name":"target","type":"address"}],"name":"AddressEmptyCode","type":"error"},{"inputs":[{"internalType":"address","name":"implementation","type":"address"}],"name":"ERC1967InvalidImplementation","type":"error"},{"inputs":[],"name":"ERC1967NonPayable","type":"error"},{"inputs":[],"name":"FailedInnerCall","type":"error"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"previousAdmin","type":"address"},{"indexed":false,"internalType":"address","name":"newAdmin","type":"address"}],"name":"AdminChanged","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"beacon","type":"address"}],"name":"BeaconUpgraded","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"implementation","type":"address"}],"name":"Upgraded","type":"event"},{"stateMutability":"payable","type":"fallback"}]`))
+	assert.NoError(t, err)
+
+	discoveries, err := detector.Detect(contract)
+	assert.NoError(t, err)
+
+	levels := make(map[shared.Standard]shared.ConfidenceLevel)
+	for _, discovery := range discoveries {
+		levels[discovery.Standard] = discovery.Confidence
+	}
+	assert.Equal(t, shared.PerfectConfidence, levels[ERC1967])
+	assert.Less(t, levels[ERC1822], shared.HighConfidence)
+	assert.NotContains(t, levels, ERC1820)
+}
+
+func TestDetectorDetectRoyaltyNFT(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	// ABI of an OpenZeppelin ERC721Enumerable collection with ERC2981 royalties, trimmed to its external interface.
+	contract, err := shared.NewContractMatcherFromABI("RoyaltyCollection", []byte(`[`+
+		`{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"approve","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"getApproved","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"uint256","name":"salePrice","type":"uint256"}],"name":"royaltyInfo","outputs":[{"internalType":"address","name":"","type":"address"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"uint256","name":"index","type":"uint256"}],"name":"tokenByIndex","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"approved","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Approval","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Transfer","type":"event"}]`))
+	assert.NoError(t, err)
+
+	discoveries, err := detector.Detect(contract)
+	assert.NoError(t, err)
+
+	levels := make(map[shared.Standard]shared.ConfidenceLevel)
+	for _, discovery := range discoveries {
+		levels[discovery.Standard] = discovery.Confidence
+	}
+
+	// Royalties are reported next to the collection, as ERC-2981 extends ERC-721, while ERC-20 lookalikes are not.
+	assert.Equal(t, shared.PerfectConfidence, levels[ERC721])
+	assert.Equal(t, shared.PerfectConfidence, levels[ERC2981])
+	assert.NotContains(t, levels, ERC20)
+	assert.Less(t, levels[ERC1155], shared.HighConfidence)
+	assert.Less(t, levels[ERC4907], shared.HighConfidence)
+}
+
+func TestDetectorDetectXERC20(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	// ABI of the xERC20 reference implementation by defi-wonderland, trimmed to its external interface.
+	contract, err := shared.NewContractMatcherFromABI("XERC20", []byte(`[`+
+		`{"inputs":[],"name":"IXERC20_NotFactory","type":"error"},`+
+		`{"inputs":[],"name":"IXERC20_NotHighEnoughLimits","type":"error"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"spender","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Approval","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"_mintingLimit","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"_burningLimit","type":"uint256"},{"indexed":true,"internalType":"address","name":"_bridge","type":"address"}],"name":"BridgeLimitsSet","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"_lockbox","type":"address"}],"name":"LockboxSet","type":"event"},`+
+		`{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Transfer","type":"event"},`+
+		`{"inputs":[],"name":"FACTORY","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"}],"name":"allowance","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"approve","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_user","type":"address"},{"internalType":"uint256","name":"_amount","type":"uint256"}],"name":"burn","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_bridge","type":"address"}],"name":"burningCurrentLimitOf","outputs":[{"internalType":"uint256","name":"_limit","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_bridge","type":"address"}],"name":"burningMaxLimitOf","outputs":[{"internalType":"uint256","name":"_limit","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"lockbox","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_user","type":"address"},{"internalType":"uint256","name":"_amount","type":"uint256"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_bridge","type":"address"}],"name":"mintingCurrentLimitOf","outputs":[{"internalType":"uint256","name":"_limit","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_bridge","type":"address"}],"name":"mintingMaxLimitOf","outputs":[{"internalType":"uint256","name":"_limit","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_bridge","type":"address"},{"internalType":"uint256","name":"_mintingLimit","type":"uint256"},{"internalType":"uint256","name":"_burningLimit","type":"uint256"}],"name":"setLimits","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"_lockbox","type":"address"}],"name":"setLockbox","outputs":[],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},`+
+		`{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transferFrom","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`))
+	assert.NoError(t, err)
+
+	discoveries, err := detector.Detect(contract)
+	assert.NoError(t, err)
+
+	found := make(map[shared.Standard]shared.Discovery)
+	for _, discovery := range discoveries {
+		found[discovery.Standard] = discovery
+	}
+
+	// The bridged token is reported both as its underlying ERC-20 and as a bridging standard.
+	assert.Equal(t, shared.PerfectConfidence, found[ERC7281].Confidence)
+	assert.Equal(t, shared.CategoryBridging, found[ERC7281].Category)
+	assert.Equal(t, shared.PerfectConfidence, found[ERC20].Confidence)
+	assert.Equal(t, shared.CategoryToken, found[ERC20].Category)
+	assert.Less(t, found[LAYERZEROOFT].Confidence, shared.HighConfidence)
+}
@@ -5,28 +5,30 @@ import "github.com/unpackdev/standards/shared"
 // standards is a map that stores ContractStandard instances indexed by their Standard identifier.
 var standards = map[shared.Standard]shared.ContractStandard{
 	ERC20: {
-		Name: "ERC-20 Token Standard",
-		Url:  "https://eips.ethereum.org/EIPS/eip-20",
-		Type: ERC20,
-		ABI:  `[{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_spender","type":"address"},{"name":"_value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"_owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"_owner","type":"address"},{"name":"_spender","type":"address"}],"name":"allowance","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"payable":true,"stateMutability":"payable","type":"fallback"},{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`,
-		Functions: []shared.Function{
-			shared.NewFunction("totalSupply", nil, []shared.Output{{Type: shared.TypeUint256}}),
-			shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
-			shared.NewFunction("transfer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
-			shared.NewFunction("transferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
-			shared.NewFunction("approve", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
-			shared.NewFunction("allowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
-		},
-		Events: []shared.Event{
-			shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
-			shared.NewEvent("Approval", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
-		},
+		Name:     "ERC-20 Token Standard",
+		Url:      "https://eips.ethereum.org/EIPS/eip-20",
+		Type:     ERC20,
+		EIP:      20,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"fungible"},
+		Authors:  []string{"Fabian Vogelsteller", "Vitalik Buterin"},
+		Created:  "2015-11-19",
+		// ABI, functions, events and errors are generated into the directory/openzeppelin package.
 	},
 	ERC721: {
-		Name: "ERC-721 Non-Fungible Token Standard",
-		Url:  "https://eips.ethereum.org/EIPS/eip-721",
-		Type: ERC721,
-		ABI:  `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"approved","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"approve","outputs":[],"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"getApproved","outputs":[{"internalType":"address","name":"operator","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"internalType":"address","name":"owner","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"_approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Name:     "ERC-721 Non-Fungible Token Standard",
+		Url:      "https://eips.ethereum.org/EIPS/eip-721",
+		Type:     ERC721,
+		EIP:      721,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft"},
+		Authors:  []string{"William Entriken", "Dieter Shirley", "Jacob Evans", "Nastassia Sachs"},
+		Requires: []shared.Standard{ERC165},
+		Created:  "2018-01-24",
+		Version:  "final",
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"approved","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"approve","outputs":[],"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"getApproved","outputs":[{"internalType":"address","name":"operator","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"internalType":"address","name":"owner","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"_approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
 		Functions: []shared.Function{
 			shared.NewFunction("name", nil, []shared.Output{{Type: shared.TypeString}}),
 			shared.NewFunction("symbol", nil, []shared.Output{{Type: shared.TypeString}}),
@@ -40,99 +42,1050 @@ var standards = map[shared.Standard]shared.ContractStandard{
 			shared.NewFunction("isApprovedForAll", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
 		},
 		Events: []shared.Event{
-			shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
-			shared.NewEvent("Approval", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256, Indexed: true}}, nil),
+			shared.NewEvent("Approval", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256, Indexed: true}}, nil),
 			shared.NewEvent("ApprovalForAll", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeBool}}, nil),
 		},
-	},
-	/*	ERC1155: {
-			Name: "ERC-1155 Multi Token Standard",
-			Url:  "https://eips.ethereum.org/EIPS/eip-1155",
-			Type: ERC1155,
-			ABI:  `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256[]","name":"ids","type":"uint256[]"},{"indexed":false,"internalType":"uint256[]","name":"values","type":"uint256[]"}],"name":"TransferBatch","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"id","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"TransferSingle","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"value","type":"string"},{"indexed":true,"internalType":"uint256","name":"id","type":"uint256"}],"name":"URI","type":"event"},{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"uint256","name":"id","type":"uint256"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address[]","name":"accounts","type":"address[]"},{"internalType":"uint256[]","name":"ids","type":"uint256[]"}],"name":"balanceOfBatch","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256[]","name":"ids","type":"uint256[]"},{"internalType":"uint256[]","name":"amounts","type":"uint256[]"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeBatchTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"}],"name":"uri","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}]`,
-			Functions: []Function{
-				newFunction("safeTransferFrom", []Input{{Type: TypeAddress}, {Type: TypeAddress}, {Type: TypeUint256}, {Type: TypeUint256}, {Type: TypeBytes}}, nil),
-				newFunction("safeBatchTransferFrom", []Input{{Type: TypeAddress}, {Type: TypeAddress}, {Type: TypeUint256Array}, {Type: TypeUint256Array}, {Type: TypeBytes}}, nil),
-				newFunction("balanceOf", []Input{{Type: TypeAddress}, {Type: TypeUint256}}, []Output{{Type: TypeUint256}}),
-				newFunction("balanceOfBatch", []Input{{Type: TypeAddressArray}, {Type: TypeUint256Array}}, []Output{{Type: TypeUint256Array}}),
-				newFunction("setApprovalForAll", []Input{{Type: TypeAddress}, {Type: TypeBool}}, nil),
-				newFunction("isApprovedForAll", []Input{{Type: TypeAddress}, {Type: TypeAddress}}, []Output{{Type: TypeBool}}),
-			},
-			Events: []Event{
-				newEvent("TransferSingle", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256}, {Type: TypeUint256}}, nil),
-				newEvent("TransferBatch", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeAddressArray, Indexed: true}, {Type: TypeUint256Array}, {Type: TypeUint256Array}}, nil),
-				newEvent("ApprovalForAll", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeBool}}, nil),
-				newEvent("URI", []Input{{Type: TypeString, Indexed: false}, {Type: TypeUint256, Indexed: true}}, nil),
+		Versions: []shared.ContractStandard{
+			{
+				Version: "draft",
+				Url:     "https://github.com/ethereum/EIPs/issues/721",
+				ABI:     `[{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"approve","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transfer","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"from","type":"address"},{"indexed":false,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"owner","type":"address"},{"indexed":false,"internalType":"address","name":"approved","type":"address"},{"indexed":false,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Approval","type":"event"}]`,
+				Functions: []shared.Function{
+					shared.NewFunction("totalSupply", nil, []shared.Output{{Type: shared.TypeUint256}}),
+					shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+					shared.NewFunction("ownerOf", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeAddress}}),
+					shared.NewFunction("approve", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+					shared.NewFunction("transfer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+					shared.NewFunction("transferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+				},
+				Events: []shared.Event{
+					shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+					shared.NewEvent("Approval", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+				},
 			},
 		},
-		ERC1820: {
-			Name: "ERC-1820 Pseudo-introspection Registry Contract",
-			Url:  "https://eips.ethereum.org/EIPS/eip-1820",
-			Type: ERC1820,
-			ABI:  `[{"constant":true,"inputs":[{"name":"account","type":"address"},{"name":"interfaceHash","type":"bytes32"}],"name":"getInterfaceImplementer","outputs":[{"name":"implementer","type":"address"}],"type":"function"},{"constant":false,"inputs":[{"name":"interfaceHash","type":"bytes32"},{"name":"implementer","type":"address"}],"name":"setInterfaceImplementer","outputs":[],"type":"function"},{"constant":false,"inputs":[{"name":"newManager","type":"address"}],"name":"setManager","outputs":[],"type":"function"},{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"getManager","outputs":[{"name":"manager","type":"address"}],"type":"function"},{"constant":false,"inputs":[{"name":"account","type":"address"}],"name":"setInterfaceImplementer","outputs":[],"type":"function"},{"inputs":[],"type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"name":"account","type":"address"},{"indexed":true,"name":"interfaceHash","type":"bytes32"},{"indexed":true,"name":"implementer","type":"address"}],"name":"InterfaceImplementerSet","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"account","type":"address"},{"indexed":true,"name":"newManager","type":"address"}],"name":"ManagerChanged","type":"event"}]`,
-			Functions: []Function{
-				newFunction("setInterfaceImplementer", []Input{{Type: TypeAddress}, {Type: TypeBytes32}, {Type: TypeAddress}}, nil),
-				newFunction("getInterfaceImplementer", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeAddress}}),
-				newFunction("interfaceHash", []Input{{Type: TypeString}}, []Output{{Type: TypeBytes32}}),
-				newFunction("updateERC165Cache", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, nil),
-				newFunction("implementsERC165InterfaceNoCache", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeBool}}),
-				newFunction("implementsERC165Interface", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeBool}}),
-			},
-			Events: []Event{
-				newEvent("InterfaceImplementerSet", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeBytes32, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-				newEvent("ManagerChanged", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-			},
+	},
+	ERC1155: {
+		Name:     "ERC-1155 Multi Token Standard",
+		Url:      "https://eips.ethereum.org/EIPS/eip-1155",
+		Type:     ERC1155,
+		EIP:      1155,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"multi-token", "nft"},
+		Authors:  []string{"Witek Radomski", "Andrew Cooke", "Philippe Castonguay", "James Therien", "Eric Binet", "Ronan Sandford"},
+		Requires: []shared.Standard{ERC165},
+		Created:  "2018-06-17",
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256[]","name":"ids","type":"uint256[]"},{"indexed":false,"internalType":"uint256[]","name":"values","type":"uint256[]"}],"name":"TransferBatch","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"id","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"TransferSingle","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"string","name":"value","type":"string"},{"indexed":true,"internalType":"uint256","name":"id","type":"uint256"}],"name":"URI","type":"event"},{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"uint256","name":"id","type":"uint256"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address[]","name":"accounts","type":"address[]"},{"internalType":"uint256[]","name":"ids","type":"uint256[]"}],"name":"balanceOfBatch","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256[]","name":"ids","type":"uint256[]"},{"internalType":"uint256[]","name":"amounts","type":"uint256[]"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeBatchTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"}],"name":"uri","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("safeTransferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("safeBatchTransferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256Array}, {Type: shared.TypeUint256Array}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("balanceOfBatch", []shared.Input{{Type: shared.TypeAddressArray}, {Type: shared.TypeUint256Array}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("setApprovalForAll", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBool}}, nil),
+			shared.NewFunction("isApprovedForAll", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
 		},
-		ERC1822: {
-			Name:     "ERC-1822 Universal Proxy Standard (UPS)",
-			Url:      "https://eips.ethereum.org/EIPS/eip-1822",
-			Stagnant: true,
-			Type:     ERC1822,
-			ABI:      `[{"constant":true,"inputs":[],"name":"getImplementation","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"}],"name":"upgradeTo","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"},{"name":"","type":"string"}],"name":"upgradeToAndCall","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"}],"name":"setProxyOwner","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"}],"name":"Upgraded","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"},{"indexed":true,"name":"","type":"address"}],"name":"ProxyOwnershipTransferred","type":"event"}]`,
-			Functions: []Function{
-				newFunction("getImplementation", nil, []Output{{Type: TypeAddress}}),
-				newFunction("upgradeTo", []Input{{Type: TypeAddress}}, nil),
-				newFunction("upgradeToAndCall", []Input{{Type: TypeAddress, Indexed: false}, {Type: TypeString, Indexed: false}}, nil),
-				newFunction("setProxyOwner", []Input{{Type: TypeAddress}}, nil),
-			},
-			Events: []Event{
-				newEvent("Upgraded", []Input{{Type: TypeAddress, Indexed: true}}, nil),
-				newEvent("ProxyOwnershipTransferred", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-			},
+		Events: []shared.Event{
+			shared.NewEvent("TransferSingle", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("TransferBatch", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256Array}, {Type: shared.TypeUint256Array}}, nil),
+			shared.NewEvent("ApprovalForAll", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeBool}}, nil),
+			shared.NewEvent("URI", []shared.Input{{Type: shared.TypeString, Indexed: false}, {Type: shared.TypeUint256, Indexed: true}}, nil),
 		},
-		ERC1967: {
-			Name: "ERC-1967 Proxy Storage Slots",
-			Url:  "https://eips.ethereum.org/EIPS/eip-1967",
-			Type: ERC1967,
-			ABI:  `[{"constant":false,"inputs":[{"name":"","type":"address"},{"name":"","type":"bytes32"},{"name":"","type":"address"}],"name":"setInterfaceImplementer","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"},{"name":"","type":"bytes32"}],"name":"getInterfaceImplementer","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"","type":"string"}],"name":"interfaceHash","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"},{"name":"","type":"bytes32"}],"name":"updateERC165Cache","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"},{"name":"","type":"bytes32"}],"name":"implementsERC165InterfaceNoCache","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"},{"name":"","type":"bytes32"}],"name":"implementsERC165Interface","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"},{"indexed":true,"name":"","type":"bytes32"},{"indexed":true,"name":"","type":"address"}],"name":"InterfaceImplementerSet","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"},{"indexed":true,"name":"","type":"address"}],"name":"AdminChanged","type":"event"}]`,
-			Functions: []Function{
-				newFunction("setInterfaceImplementer", []Input{{Type: TypeAddress}, {Type: TypeBytes32}, {Type: TypeAddress}}, nil),
-				newFunction("getInterfaceImplementer", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeAddress}}),
-				newFunction("interfaceHash", []Input{{Type: TypeString}}, []Output{{Type: TypeBytes32}}),
-				newFunction("updateERC165Cache", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, nil),
-				newFunction("implementsERC165InterfaceNoCache", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeBool}}),
-				newFunction("implementsERC165Interface", []Input{{Type: TypeAddress}, {Type: TypeBytes32}}, []Output{{Type: TypeBool}}),
-			},
-			Events: []Event{
-				newEvent("InterfaceImplementerSet", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeBytes32, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-				newEvent("AdminChanged", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-			},
+	},
+	ERC1820: {
+		Name:     "ERC-1820 Pseudo-introspection Registry Contract",
+		Url:      "https://eips.ethereum.org/EIPS/eip-1820",
+		Type:     ERC1820,
+		EIP:      1820,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryInfrastructure,
+		Tags:     []string{"registry", "introspection"},
+		Authors:  []string{"Jordi Baylina", "Jacques Dafflon"},
+		Requires: []shared.Standard{ERC165},
+		Created:  "2019-03-04",
+		ABI:      `[{"constant":true,"inputs":[{"name":"account","type":"address"},{"name":"interfaceHash","type":"bytes32"}],"name":"getInterfaceImplementer","outputs":[{"name":"implementer","type":"address"}],"type":"function"},{"constant":false,"inputs":[{"name":"interfaceHash","type":"bytes32"},{"name":"implementer","type":"address"}],"name":"setInterfaceImplementer","outputs":[],"type":"function"},{"constant":false,"inputs":[{"name":"newManager","type":"address"}],"name":"setManager","outputs":[],"type":"function"},{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"getManager","outputs":[{"name":"manager","type":"address"}],"type":"function"},{"constant":false,"inputs":[{"name":"account","type":"address"}],"name":"setInterfaceImplementer","outputs":[],"type":"function"},{"inputs":[],"type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"name":"account","type":"address"},{"indexed":true,"name":"interfaceHash","type":"bytes32"},{"indexed":true,"name":"implementer","type":"address"}],"name":"InterfaceImplementerSet","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"account","type":"address"},{"indexed":true,"name":"newManager","type":"address"}],"name":"ManagerChanged","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("setInterfaceImplementer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("getInterfaceImplementer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("interfaceHash", []shared.Input{{Type: shared.TypeString}}, []shared.Output{{Type: shared.TypeBytes32}}),
+			shared.NewFunction("updateERC165Cache", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}}, nil),
+			shared.NewFunction("implementsERC165InterfaceNoCache", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("implementsERC165Interface", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeBool}}),
 		},
-		OZOWNABLE: {
-			Name: "OpenZeppelin Owner Module",
-			Url:  "https://docs.openzeppelin.com/contracts/4.x/api/access#Ownable",
-			Type: OZOWNABLE,
-			ABI:  `[{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"OwnableInvalidOwner","type":"error"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"OwnableUnauthorizedAccount","type":"error"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"previousOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnershipTransferStarted","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"previousOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"},{"inputs":[],"name":"acceptOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"pendingOwner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"renounceOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"newOwner","type":"address"}],"name":"transferOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
-			Functions: []Function{
-				newFunction("acceptOwnership", nil, nil),
-				newFunction("owner", nil, []Output{{Type: TypeAddress}}),
-				newFunction("pendingOwner", nil, []Output{{Type: TypeAddress}}),
-				newFunction("renounceOwnership", nil, nil),
-				newFunction("transferOwnership", []Input{{Type: TypeAddress}}, nil),
-			},
-			Events: []Event{
-				newEvent("OwnershipTransferStarted", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
-				newEvent("OwnershipTransferred", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}}, nil),
+		Events: []shared.Event{
+			shared.NewEvent("InterfaceImplementerSet", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("ManagerChanged", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+	},
+	ERC1822: {
+		Name:     "ERC-1822 Universal Proxy Standard (UPS)",
+		Url:      "https://eips.ethereum.org/EIPS/eip-1822",
+		Type:     ERC1822,
+		EIP:      1822,
+		Status:   shared.StatusStagnant,
+		Category: shared.CategoryProxy,
+		Tags:     []string{"upgradeable"},
+		Authors:  []string{"Gabriel Barros", "Patrick Gallagher"},
+		Created:  "2019-03-04",
+		ABI:      `[{"constant":true,"inputs":[],"name":"getImplementation","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"}],"name":"upgradeTo","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"},{"name":"","type":"string"}],"name":"upgradeToAndCall","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"","type":"address"}],"name":"setProxyOwner","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"}],"name":"Upgraded","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"","type":"address"},{"indexed":true,"name":"","type":"address"}],"name":"ProxyOwnershipTransferred","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("getImplementation", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("upgradeTo", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("upgradeToAndCall", []shared.Input{{Type: shared.TypeAddress, Indexed: false}, {Type: shared.TypeString, Indexed: false}}, nil),
+			shared.NewFunction("setProxyOwner", []shared.Input{{Type: shared.TypeAddress}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Upgraded", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("ProxyOwnershipTransferred", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+	},
+	ERC1967: {
+		Name:     "ERC-1967 Proxy Storage Slots",
+		Url:      "https://eips.ethereum.org/EIPS/eip-1967",
+		Type:     ERC1967,
+		EIP:      1967,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryProxy,
+		Tags:     []string{"upgradeable"},
+		Authors:  []string{"Santiago Palladino", "Francisco Giordano", "Hadrien Croubois"},
+		Created:  "2019-04-24",
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"previousAdmin","type":"address"},{"indexed":false,"internalType":"address","name":"newAdmin","type":"address"}],"name":"AdminChanged","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"beacon","type":"address"}],"name":"BeaconUpgraded","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"implementation","type":"address"}],"name":"Upgraded","type":"event"}]`,
+		Events: []shared.Event{
+			shared.NewEvent("Upgraded", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("AdminChanged", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, nil),
+			shared.NewEvent("BeaconUpgraded", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+	},
+	OZOWNABLE: {
+		Name:     "OpenZeppelin Owner Module",
+		Url:      "https://docs.openzeppelin.com/contracts/5.x/api/access#Ownable",
+		Type:     OZOWNABLE,
+		Category: shared.CategoryAccess,
+		Tags:     []string{"ownership", "openzeppelin"},
+		Authors:  []string{"OpenZeppelin"},
+		Version:  "v5",
+		// ABI, functions, events and errors are generated into the directory/openzeppelin package.
+		Versions: []shared.ContractStandard{
+			{
+				Version: "v4",
+				Url:     "https://docs.openzeppelin.com/contracts/4.x/api/access#Ownable",
+				ABI:     `[{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"renounceOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"newOwner","type":"address"}],"name":"transferOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"previousOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"}]`,
+				Functions: []shared.Function{
+					shared.NewFunction("owner", nil, []shared.Output{{Type: shared.TypeAddress}}),
+					shared.NewFunction("renounceOwnership", nil, nil),
+					shared.NewFunction("transferOwnership", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				},
+				Events: []shared.Event{
+					shared.NewEvent("OwnershipTransferred", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+				},
 			},
-		},*/
+		},
+	},
+	UNISWAPV2: {
+		Name:     "Uniswap V2 Pair",
+		Url:      "https://docs.uniswap.org/contracts/v2/reference/smart-contracts/pair",
+		Type:     UNISWAPV2,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Mint","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"},{"indexed":true,"internalType":"address","name":"to","type":"address"}],"name":"Burn","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0In","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1In","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount0Out","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1Out","type":"uint256"},{"indexed":true,"internalType":"address","name":"to","type":"address"}],"name":"Swap","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint112","name":"reserve0","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"reserve1","type":"uint112"}],"name":"Sync","type":"event"},{"inputs":[],"name":"MINIMUM_LIQUIDITY","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token0","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token1","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getReserves","outputs":[{"internalType":"uint112","name":"reserve0","type":"uint112"},{"internalType":"uint112","name":"reserve1","type":"uint112"},{"internalType":"uint32","name":"blockTimestampLast","type":"uint32"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"price0CumulativeLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"price1CumulativeLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"kLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"mint","outputs":[{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"burn","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amount0Out","type":"uint256"},{"internalType":"uint256","name":"amount1Out","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"swap","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"skim","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"sync","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"},{"internalType":"address","name":"","type":"address"}],"name":"initialize","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("MINIMUM_LIQUIDITY", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("factory", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("token0", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("token1", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("getReserves", nil, []shared.Output{{Type: shared.TypeUint112}, {Type: shared.TypeUint112}, {Type: shared.TypeUint32}}),
+			shared.NewFunction("price0CumulativeLast", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("price1CumulativeLast", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("kLast", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("mint", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("burn", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("swap", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("skim", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("sync", nil, nil),
+			shared.NewFunction("initialize", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Mint", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Burn", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("Swap", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("Sync", []shared.Input{{Type: shared.TypeUint112}, {Type: shared.TypeUint112}}, nil),
+		},
+	},
+	UNISWAPV2FACTORY: {
+		Name:     "Uniswap V2 Factory",
+		Url:      "https://docs.uniswap.org/contracts/v2/reference/smart-contracts/factory",
+		Type:     UNISWAPV2FACTORY,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"token0","type":"address"},{"indexed":true,"internalType":"address","name":"token1","type":"address"},{"indexed":false,"internalType":"address","name":"pair","type":"address"},{"indexed":false,"internalType":"uint256","name":"","type":"uint256"}],"name":"PairCreated","type":"event"},{"inputs":[],"name":"feeTo","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"feeToSetter","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"}],"name":"getPair","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"","type":"uint256"}],"name":"allPairs","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"allPairsLength","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"}],"name":"createPair","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"}],"name":"setFeeTo","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"}],"name":"setFeeToSetter","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("feeTo", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("feeToSetter", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("getPair", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("allPairs", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("allPairsLength", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("createPair", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("setFeeTo", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("setFeeToSetter", []shared.Input{{Type: shared.TypeAddress}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("PairCreated", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	UNISWAPV2ROUTER: {
+		Name:     "Uniswap V2 Router",
+		Url:      "https://docs.uniswap.org/contracts/v2/reference/smart-contracts/router-02",
+		Type:     UNISWAPV2ROUTER,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"WETH","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"amountADesired","type":"uint256"},{"internalType":"uint256","name":"amountBDesired","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amountTokenDesired","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapETHForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETHSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"reserveA","type":"uint256"},{"internalType":"uint256","name":"reserveB","type":"uint256"}],"name":"quote","outputs":[{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountOut","outputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountIn","outputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsOut","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsIn","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("factory", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("WETH", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("addLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("addLiquidityETH", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("removeLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("removeLiquidityETH", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("swapExactTokensForTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapTokensForExactTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapExactETHForTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapTokensForExactETH", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapExactTokensForETH", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapETHForExactTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("swapExactTokensForTokensSupportingFeeOnTransferTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("swapExactETHForTokensSupportingFeeOnTransferTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("swapExactTokensForETHSupportingFeeOnTransferTokens", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("quote", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getAmountOut", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getAmountIn", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getAmountsOut", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+			shared.NewFunction("getAmountsIn", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddressArray}}, []shared.Output{{Type: shared.TypeUint256Array}}),
+		},
+	},
+	UNISWAPV3POOL: {
+		Name:     "Uniswap V3 Pool",
+		Url:      "https://docs.uniswap.org/contracts/v3/reference/core/UniswapV3Pool",
+		Type:     UNISWAPV3POOL,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"},{"indexed":false,"internalType":"int24","name":"tick","type":"int24"}],"name":"Initialize","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"int24","name":"tickLower","type":"int24"},{"indexed":true,"internalType":"int24","name":"tickUpper","type":"int24"},{"indexed":false,"internalType":"uint128","name":"amount","type":"uint128"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Mint","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":false,"internalType":"address","name":"recipient","type":"address"},{"indexed":true,"internalType":"int24","name":"tickLower","type":"int24"},{"indexed":true,"internalType":"int24","name":"tickUpper","type":"int24"},{"indexed":false,"internalType":"uint128","name":"amount0","type":"uint128"},{"indexed":false,"internalType":"uint128","name":"amount1","type":"uint128"}],"name":"Collect","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"int24","name":"tickLower","type":"int24"},{"indexed":true,"internalType":"int24","name":"tickUpper","type":"int24"},{"indexed":false,"internalType":"uint128","name":"amount","type":"uint128"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Burn","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"int256","name":"amount0","type":"int256"},{"indexed":false,"internalType":"int256","name":"amount1","type":"int256"},{"indexed":false,"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"},{"indexed":false,"internalType":"uint128","name":"liquidity","type":"uint128"},{"indexed":false,"internalType":"int24","name":"tick","type":"int24"}],"name":"Swap","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"paid0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"paid1","type":"uint256"}],"name":"Flash","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint16","name":"observationCardinalityNextOld","type":"uint16"},{"indexed":false,"internalType":"uint16","name":"observationCardinalityNextNew","type":"uint16"}],"name":"IncreaseObservationCardinalityNext","type":"event"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token0","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token1","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"fee","outputs":[{"internalType":"uint24","name":"","type":"uint24"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"tickSpacing","outputs":[{"internalType":"int24","name":"","type":"int24"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"maxLiquidityPerTick","outputs":[{"internalType":"uint128","name":"","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"slot0","outputs":[{"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"},{"internalType":"int24","name":"tick","type":"int24"},{"internalType":"uint16","name":"observationIndex","type":"uint16"},{"internalType":"uint16","name":"observationCardinality","type":"uint16"},{"internalType":"uint16","name":"observationCardinalityNext","type":"uint16"},{"internalType":"uint8","name":"feeProtocol","type":"uint8"},{"internalType":"bool","name":"unlocked","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"feeGrowthGlobal0X128","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"feeGrowthGlobal1X128","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"protocolFees","outputs":[{"internalType":"uint128","name":"token0","type":"uint128"},{"internalType":"uint128","name":"token1","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"liquidity","outputs":[{"internalType":"uint128","name":"","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"int24","name":"tick","type":"int24"}],"name":"ticks","outputs":[{"internalType":"uint128","name":"liquidityGross","type":"uint128"},{"internalType":"int128","name":"liquidityNet","type":"int128"},{"internalType":"uint256","name":"feeGrowthOutside0X128","type":"uint256"},{"internalType":"uint256","name":"feeGrowthOutside1X128","type":"uint256"},{"internalType":"int56","name":"tickCumulativeOutside","type":"int56"},{"internalType":"uint160","name":"secondsPerLiquidityOutsideX128","type":"uint160"},{"internalType":"uint32","name":"secondsOutside","type":"uint32"},{"internalType":"bool","name":"initialized","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint32[]","name":"secondsAgos","type":"uint32[]"}],"name":"observe","outputs":[{"internalType":"int56[]","name":"tickCumulatives","type":"int56[]"},{"internalType":"uint160[]","name":"secondsPerLiquidityCumulativeX128s","type":"uint160[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"}],"name":"initialize","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint128","name":"amount","type":"uint128"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"mint","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint128","name":"amount0Requested","type":"uint128"},{"internalType":"uint128","name":"amount1Requested","type":"uint128"}],"name":"collect","outputs":[{"internalType":"uint128","name":"amount0","type":"uint128"},{"internalType":"uint128","name":"amount1","type":"uint128"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint128","name":"amount","type":"uint128"}],"name":"burn","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"bool","name":"zeroForOne","type":"bool"},{"internalType":"int256","name":"amountSpecified","type":"int256"},{"internalType":"uint160","name":"sqrtPriceLimitX96","type":"uint160"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"swap","outputs":[{"internalType":"int256","name":"amount0","type":"int256"},{"internalType":"int256","name":"amount1","type":"int256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"flash","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint16","name":"observationCardinalityNext","type":"uint16"}],"name":"increaseObservationCardinalityNext","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("factory", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("token0", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("token1", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("fee", nil, []shared.Output{{Type: shared.TypeUint24}}),
+			shared.NewFunction("tickSpacing", nil, []shared.Output{{Type: shared.TypeInt24}}),
+			shared.NewFunction("maxLiquidityPerTick", nil, []shared.Output{{Type: shared.TypeUint128}}),
+			shared.NewFunction("slot0", nil, []shared.Output{{Type: shared.TypeUint160}, {Type: shared.TypeInt24}, {Type: shared.TypeUint16}, {Type: shared.TypeUint16}, {Type: shared.TypeUint16}, {Type: shared.TypeUint8}, {Type: shared.TypeBool}}),
+			shared.NewFunction("feeGrowthGlobal0X128", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("feeGrowthGlobal1X128", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("protocolFees", nil, []shared.Output{{Type: shared.TypeUint128}, {Type: shared.TypeUint128}}),
+			shared.NewFunction("liquidity", nil, []shared.Output{{Type: shared.TypeUint128}}),
+			shared.NewFunction("ticks", []shared.Input{{Type: shared.TypeInt24}}, []shared.Output{{Type: shared.TypeUint128}, {Type: shared.TypeInt128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeInt56}, {Type: shared.TypeUint160}, {Type: shared.TypeUint32}, {Type: shared.TypeBool}}),
+			shared.NewFunction("observe", []shared.Input{{Type: shared.TypeUint32Array}}, []shared.Output{{Type: shared.TypeInt56Array}, {Type: shared.TypeUint160Array}}),
+			shared.NewFunction("initialize", []shared.Input{{Type: shared.TypeUint160}}, nil),
+			shared.NewFunction("mint", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeInt24}, {Type: shared.TypeInt24}, {Type: shared.TypeUint128}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("collect", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeInt24}, {Type: shared.TypeInt24}, {Type: shared.TypeUint128}, {Type: shared.TypeUint128}}, []shared.Output{{Type: shared.TypeUint128}, {Type: shared.TypeUint128}}),
+			shared.NewFunction("burn", []shared.Input{{Type: shared.TypeInt24}, {Type: shared.TypeInt24}, {Type: shared.TypeUint128}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("swap", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBool}, {Type: shared.TypeInt256}, {Type: shared.TypeUint160}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeInt256}, {Type: shared.TypeInt256}}),
+			shared.NewFunction("flash", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("increaseObservationCardinalityNext", []shared.Input{{Type: shared.TypeUint16}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Initialize", []shared.Input{{Type: shared.TypeUint160}, {Type: shared.TypeInt24}}, nil),
+			shared.NewEvent("Mint", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Collect", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeUint128}, {Type: shared.TypeUint128}}, nil),
+			shared.NewEvent("Burn", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Swap", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeInt256}, {Type: shared.TypeInt256}, {Type: shared.TypeUint160}, {Type: shared.TypeUint128}, {Type: shared.TypeInt24}}, nil),
+			shared.NewEvent("Flash", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("IncreaseObservationCardinalityNext", []shared.Input{{Type: shared.TypeUint16}, {Type: shared.TypeUint16}}, nil),
+		},
+	},
+	UNISWAPV3FACTORY: {
+		Name:     "Uniswap V3 Factory",
+		Url:      "https://docs.uniswap.org/contracts/v3/reference/core/UniswapV3Factory",
+		Type:     UNISWAPV3FACTORY,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"oldOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnerChanged","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"token0","type":"address"},{"indexed":true,"internalType":"address","name":"token1","type":"address"},{"indexed":true,"internalType":"uint24","name":"fee","type":"uint24"},{"indexed":false,"internalType":"int24","name":"tickSpacing","type":"int24"},{"indexed":false,"internalType":"address","name":"pool","type":"address"}],"name":"PoolCreated","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint24","name":"fee","type":"uint24"},{"indexed":true,"internalType":"int24","name":"tickSpacing","type":"int24"}],"name":"FeeAmountEnabled","type":"event"},{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint24","name":"fee","type":"uint24"}],"name":"feeAmountTickSpacing","outputs":[{"internalType":"int24","name":"","type":"int24"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"}],"name":"getPool","outputs":[{"internalType":"address","name":"pool","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"}],"name":"createPool","outputs":[{"internalType":"address","name":"pool","type":"address"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"_owner","type":"address"}],"name":"setOwner","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"}],"name":"enableFeeAmount","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("owner", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("feeAmountTickSpacing", []shared.Input{{Type: shared.TypeUint24}}, []shared.Output{{Type: shared.TypeInt24}}),
+			shared.NewFunction("getPool", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint24}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("createPool", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint24}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("setOwner", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("enableFeeAmount", []shared.Input{{Type: shared.TypeUint24}, {Type: shared.TypeInt24}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("OwnerChanged", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("PoolCreated", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint24, Indexed: true}, {Type: shared.TypeInt24}, {Type: shared.TypeAddress}}, nil),
+			shared.NewEvent("FeeAmountEnabled", []shared.Input{{Type: shared.TypeUint24, Indexed: true}, {Type: shared.TypeInt24, Indexed: true}}, nil),
+		},
+	},
+	UNISWAPV3POSITIONMANAGER: {
+		Name:     "Uniswap V3 Nonfungible Position Manager",
+		Url:      "https://docs.uniswap.org/contracts/v3/reference/periphery/NonfungiblePositionManager",
+		Type:     UNISWAPV3POSITIONMANAGER,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap", "nft"},
+		Authors:  []string{"Uniswap Labs"},
+		Requires: []shared.Standard{ERC721},
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":false,"internalType":"uint128","name":"liquidity","type":"uint128"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"IncreaseLiquidity","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":false,"internalType":"uint128","name":"liquidity","type":"uint128"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"DecreaseLiquidity","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":false,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Collect","type":"event"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"WETH9","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"positions","outputs":[{"internalType":"uint96","name":"nonce","type":"uint96"},{"internalType":"address","name":"operator","type":"address"},{"internalType":"address","name":"token0","type":"address"},{"internalType":"address","name":"token1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"feeGrowthInside0LastX128","type":"uint256"},{"internalType":"uint256","name":"feeGrowthInside1LastX128","type":"uint256"},{"internalType":"uint128","name":"tokensOwed0","type":"uint128"},{"internalType":"uint128","name":"tokensOwed1","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"token0","type":"address"},{"internalType":"address","name":"token1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"}],"name":"createAndInitializePoolIfNecessary","outputs":[{"internalType":"address","name":"pool","type":"address"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"token0","type":"address"},{"internalType":"address","name":"token1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint256","name":"amount0Desired","type":"uint256"},{"internalType":"uint256","name":"amount1Desired","type":"uint256"},{"internalType":"uint256","name":"amount0Min","type":"uint256"},{"internalType":"uint256","name":"amount1Min","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"internalType":"struct MintParams","name":"params","type":"tuple"}],"name":"mint","outputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"uint256","name":"amount0Desired","type":"uint256"},{"internalType":"uint256","name":"amount1Desired","type":"uint256"},{"internalType":"uint256","name":"amount0Min","type":"uint256"},{"internalType":"uint256","name":"amount1Min","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"internalType":"struct IncreaseLiquidityParams","name":"params","type":"tuple"}],"name":"increaseLiquidity","outputs":[{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"amount0Min","type":"uint256"},{"internalType":"uint256","name":"amount1Min","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"internalType":"struct DecreaseLiquidityParams","name":"params","type":"tuple"}],"name":"decreaseLiquidity","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint128","name":"amount0Max","type":"uint128"},{"internalType":"uint128","name":"amount1Max","type":"uint128"}],"internalType":"struct CollectParams","name":"params","type":"tuple"}],"name":"collect","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"burn","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"refundETH","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amountMinimum","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"}],"name":"sweepToken","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountMinimum","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"}],"name":"unwrapWETH9","outputs":[],"stateMutability":"payable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("factory", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("WETH9", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("positions", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint96}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint24}, {Type: shared.TypeInt24}, {Type: shared.TypeInt24}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint128}, {Type: shared.TypeUint128}}),
+			shared.NewFunction("createAndInitializePoolIfNecessary", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint24}, {Type: shared.TypeUint160}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("mint", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "token0", Type: shared.TypeAddress}, {Name: "token1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickLower", Type: shared.TypeInt24}, {Name: "tickUpper", Type: shared.TypeInt24}, {Name: "amount0Desired", Type: shared.TypeUint256}, {Name: "amount1Desired", Type: shared.TypeUint256}, {Name: "amount0Min", Type: shared.TypeUint256}, {Name: "amount1Min", Type: shared.TypeUint256}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "deadline", Type: shared.TypeUint256}}}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("increaseLiquidity", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokenId", Type: shared.TypeUint256}, {Name: "amount0Desired", Type: shared.TypeUint256}, {Name: "amount1Desired", Type: shared.TypeUint256}, {Name: "amount0Min", Type: shared.TypeUint256}, {Name: "amount1Min", Type: shared.TypeUint256}, {Name: "deadline", Type: shared.TypeUint256}}}}, []shared.Output{{Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("decreaseLiquidity", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokenId", Type: shared.TypeUint256}, {Name: "liquidity", Type: shared.TypeUint128}, {Name: "amount0Min", Type: shared.TypeUint256}, {Name: "amount1Min", Type: shared.TypeUint256}, {Name: "deadline", Type: shared.TypeUint256}}}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("collect", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokenId", Type: shared.TypeUint256}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "amount0Max", Type: shared.TypeUint128}, {Name: "amount1Max", Type: shared.TypeUint128}}}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("burn", []shared.Input{{Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("refundETH", nil, nil),
+			shared.NewFunction("sweepToken", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("unwrapWETH9", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("IncreaseLiquidity", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("DecreaseLiquidity", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeUint128}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Collect", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	UNISWAPV4HOOKS: {
+		Name:     "Uniswap V4 Hooks",
+		Url:      "https://docs.uniswap.org/contracts/v4/concepts/hooks",
+		Type:     UNISWAPV4HOOKS,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap", "hooks"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"}],"name":"beforeInitialize","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"internalType":"uint160","name":"sqrtPriceX96","type":"uint160"},{"internalType":"int24","name":"tick","type":"int24"}],"name":"afterInitialize","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"int256","name":"liquidityDelta","type":"int256"},{"internalType":"bytes32","name":"salt","type":"bytes32"}],"internalType":"struct ModifyLiquidityParams","name":"params","type":"tuple"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"beforeAddLiquidity","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"int256","name":"liquidityDelta","type":"int256"},{"internalType":"bytes32","name":"salt","type":"bytes32"}],"internalType":"struct ModifyLiquidityParams","name":"params","type":"tuple"},{"internalType":"int256","name":"delta","type":"int256"},{"internalType":"int256","name":"feesAccrued","type":"int256"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"afterAddLiquidity","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"},{"internalType":"int256","name":"","type":"int256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"int256","name":"liquidityDelta","type":"int256"},{"internalType":"bytes32","name":"salt","type":"bytes32"}],"internalType":"struct ModifyLiquidityParams","name":"params","type":"tuple"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"beforeRemoveLiquidity","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"int256","name":"liquidityDelta","type":"int256"},{"internalType":"bytes32","name":"salt","type":"bytes32"}],"internalType":"struct ModifyLiquidityParams","name":"params","type":"tuple"},{"internalType":"int256","name":"delta","type":"int256"},{"internalType":"int256","name":"feesAccrued","type":"int256"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"afterRemoveLiquidity","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"},{"internalType":"int256","name":"","type":"int256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"bool","name":"zeroForOne","type":"bool"},{"internalType":"int256","name":"amountSpecified","type":"int256"},{"internalType":"uint160","name":"sqrtPriceLimitX96","type":"uint160"}],"internalType":"struct SwapParams","name":"params","type":"tuple"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"beforeSwap","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"},{"internalType":"int256","name":"","type":"int256"},{"internalType":"uint24","name":"","type":"uint24"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"components":[{"internalType":"bool","name":"zeroForOne","type":"bool"},{"internalType":"int256","name":"amountSpecified","type":"int256"},{"internalType":"uint160","name":"sqrtPriceLimitX96","type":"uint160"}],"internalType":"struct SwapParams","name":"params","type":"tuple"},{"internalType":"int256","name":"delta","type":"int256"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"afterSwap","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"},{"internalType":"int128","name":"","type":"int128"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"beforeDonate","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"components":[{"internalType":"address","name":"currency0","type":"address"},{"internalType":"address","name":"currency1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickSpacing","type":"int24"},{"internalType":"address","name":"hooks","type":"address"}],"internalType":"struct PoolKey","name":"key","type":"tuple"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"},{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"afterDonate","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("beforeInitialize", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeUint160}}, []shared.Output{{Type: shared.TypeBytes4}}),
+			shared.NewFunction("afterInitialize", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeUint160}, {Type: shared.TypeInt24}}, []shared.Output{{Type: shared.TypeBytes4}}),
+			shared.NewFunction("beforeAddLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "tickLower", Type: shared.TypeInt24}, {Name: "tickUpper", Type: shared.TypeInt24}, {Name: "liquidityDelta", Type: shared.TypeInt256}, {Name: "salt", Type: shared.TypeBytes32}}}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+			shared.NewFunction("afterAddLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "tickLower", Type: shared.TypeInt24}, {Name: "tickUpper", Type: shared.TypeInt24}, {Name: "liquidityDelta", Type: shared.TypeInt256}, {Name: "salt", Type: shared.TypeBytes32}}}, {Type: shared.TypeInt256}, {Type: shared.TypeInt256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}, {Type: shared.TypeInt256}}),
+			shared.NewFunction("beforeRemoveLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "tickLower", Type: shared.TypeInt24}, {Name: "tickUpper", Type: shared.TypeInt24}, {Name: "liquidityDelta", Type: shared.TypeInt256}, {Name: "salt", Type: shared.TypeBytes32}}}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+			shared.NewFunction("afterRemoveLiquidity", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "tickLower", Type: shared.TypeInt24}, {Name: "tickUpper", Type: shared.TypeInt24}, {Name: "liquidityDelta", Type: shared.TypeInt256}, {Name: "salt", Type: shared.TypeBytes32}}}, {Type: shared.TypeInt256}, {Type: shared.TypeInt256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}, {Type: shared.TypeInt256}}),
+			shared.NewFunction("beforeSwap", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "zeroForOne", Type: shared.TypeBool}, {Name: "amountSpecified", Type: shared.TypeInt256}, {Name: "sqrtPriceLimitX96", Type: shared.TypeUint160}}}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}, {Type: shared.TypeInt256}, {Type: shared.TypeUint24}}),
+			shared.NewFunction("afterSwap", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "zeroForOne", Type: shared.TypeBool}, {Name: "amountSpecified", Type: shared.TypeInt256}, {Name: "sqrtPriceLimitX96", Type: shared.TypeUint160}}}, {Type: shared.TypeInt256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}, {Type: shared.TypeInt128}}),
+			shared.NewFunction("beforeDonate", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+			shared.NewFunction("afterDonate", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "currency0", Type: shared.TypeAddress}, {Name: "currency1", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "tickSpacing", Type: shared.TypeInt24}, {Name: "hooks", Type: shared.TypeAddress}}}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+		},
+	},
+	ERC20ERRORS: {
+		Name:     "ERC-6093 Custom Errors for ERC-20 Tokens",
+		Url:      "https://eips.ethereum.org/EIPS/eip-6093",
+		Type:     ERC20ERRORS,
+		EIP:      6093,
+		Status:   shared.StatusLastCall,
+		Category: shared.CategoryToken,
+		Tags:     []string{"errors", "fungible"},
+		Authors:  []string{"Ernesto García", "Francisco Giordano", "Hadrien Croubois"},
+		Requires: []shared.Standard{ERC20},
+		Created:  "2022-12-06",
+		// ABI, functions, events and errors are generated into the directory/openzeppelin package.
+	},
+	ERC721ERRORS: {
+		Name:     "ERC-6093 Custom Errors for ERC-721 Tokens",
+		Url:      "https://eips.ethereum.org/EIPS/eip-6093",
+		Type:     ERC721ERRORS,
+		EIP:      6093,
+		Status:   shared.StatusLastCall,
+		Category: shared.CategoryToken,
+		Tags:     []string{"errors", "nft"},
+		Authors:  []string{"Ernesto García", "Francisco Giordano", "Hadrien Croubois"},
+		Requires: []shared.Standard{ERC721},
+		Created:  "2022-12-06",
+		ABI:      `[{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"ERC721InvalidOwner","type":"error"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ERC721NonexistentToken","type":"error"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"owner","type":"address"}],"name":"ERC721IncorrectOwner","type":"error"},{"inputs":[{"internalType":"address","name":"sender","type":"address"}],"name":"ERC721InvalidSender","type":"error"},{"inputs":[{"internalType":"address","name":"receiver","type":"address"}],"name":"ERC721InvalidReceiver","type":"error"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ERC721InsufficientApproval","type":"error"},{"inputs":[{"internalType":"address","name":"approver","type":"address"}],"name":"ERC721InvalidApprover","type":"error"},{"inputs":[{"internalType":"address","name":"operator","type":"address"}],"name":"ERC721InvalidOperator","type":"error"}]`,
+		Errors: []shared.Error{
+			shared.NewError("ERC721InvalidOwner", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC721NonexistentToken", []shared.Input{{Type: shared.TypeUint256}}),
+			shared.NewError("ERC721IncorrectOwner", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}),
+			shared.NewError("ERC721InvalidSender", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC721InvalidReceiver", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC721InsufficientApproval", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}),
+			shared.NewError("ERC721InvalidApprover", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC721InvalidOperator", []shared.Input{{Type: shared.TypeAddress}}),
+		},
+	},
+	ERC1155ERRORS: {
+		Name:     "ERC-6093 Custom Errors for ERC-1155 Tokens",
+		Url:      "https://eips.ethereum.org/EIPS/eip-6093",
+		Type:     ERC1155ERRORS,
+		EIP:      6093,
+		Status:   shared.StatusLastCall,
+		Category: shared.CategoryToken,
+		Tags:     []string{"errors", "multi-token"},
+		Authors:  []string{"Ernesto García", "Francisco Giordano", "Hadrien Croubois"},
+		Requires: []shared.Standard{ERC1155},
+		Created:  "2022-12-06",
+		// ABI, functions, events and errors are generated into the directory/openzeppelin package.
+	},
+	UNISWAPV3ROUTER: {
+		Name:     "Uniswap V3 Swap Router",
+		Url:      "https://docs.uniswap.org/contracts/v3/reference/periphery/SwapRouter",
+		Type:     UNISWAPV3ROUTER,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"dex", "uniswap"},
+		Authors:  []string{"Uniswap Labs"},
+		ABI:      `[{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"WETH9","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"tokenIn","type":"address"},{"internalType":"address","name":"tokenOut","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMinimum","type":"uint256"},{"internalType":"uint160","name":"sqrtPriceLimitX96","type":"uint160"}],"internalType":"struct ExactInputSingleParams","name":"params","type":"tuple"}],"name":"exactInputSingle","outputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"bytes","name":"path","type":"bytes"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMinimum","type":"uint256"}],"internalType":"struct ExactInputParams","name":"params","type":"tuple"}],"name":"exactInput","outputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"tokenIn","type":"address"},{"internalType":"address","name":"tokenOut","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMaximum","type":"uint256"},{"internalType":"uint160","name":"sqrtPriceLimitX96","type":"uint160"}],"internalType":"struct ExactOutputSingleParams","name":"params","type":"tuple"}],"name":"exactOutputSingle","outputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"bytes","name":"path","type":"bytes"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMaximum","type":"uint256"}],"internalType":"struct ExactOutputParams","name":"params","type":"tuple"}],"name":"exactOutput","outputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"int256","name":"amount0Delta","type":"int256"},{"internalType":"int256","name":"amount1Delta","type":"int256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"uniswapV3SwapCallback","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"refundETH","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amountMinimum","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"}],"name":"sweepToken","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountMinimum","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"}],"name":"unwrapWETH9","outputs":[],"stateMutability":"payable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("factory", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("WETH9", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("exactInputSingle", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokenIn", Type: shared.TypeAddress}, {Name: "tokenOut", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "deadline", Type: shared.TypeUint256}, {Name: "amountIn", Type: shared.TypeUint256}, {Name: "amountOutMinimum", Type: shared.TypeUint256}, {Name: "sqrtPriceLimitX96", Type: shared.TypeUint160}}}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("exactInput", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "path", Type: shared.TypeBytes}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "deadline", Type: shared.TypeUint256}, {Name: "amountIn", Type: shared.TypeUint256}, {Name: "amountOutMinimum", Type: shared.TypeUint256}}}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("exactOutputSingle", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokenIn", Type: shared.TypeAddress}, {Name: "tokenOut", Type: shared.TypeAddress}, {Name: "fee", Type: shared.TypeUint24}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "deadline", Type: shared.TypeUint256}, {Name: "amountOut", Type: shared.TypeUint256}, {Name: "amountInMaximum", Type: shared.TypeUint256}, {Name: "sqrtPriceLimitX96", Type: shared.TypeUint160}}}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("exactOutput", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "path", Type: shared.TypeBytes}, {Name: "recipient", Type: shared.TypeAddress}, {Name: "deadline", Type: shared.TypeUint256}, {Name: "amountOut", Type: shared.TypeUint256}, {Name: "amountInMaximum", Type: shared.TypeUint256}}}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("uniswapV3SwapCallback", []shared.Input{{Type: shared.TypeInt256}, {Type: shared.TypeInt256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("refundETH", nil, nil),
+			shared.NewFunction("sweepToken", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("unwrapWETH9", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+		},
+	},
+	ERC4337ENTRYPOINT: {
+		Name:     "ERC-4337 EntryPoint",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4337",
+		Type:     ERC4337ENTRYPOINT,
+		EIP:      4337,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction"},
+		Authors:  []string{"Vitalik Buterin", "Yoav Weiss", "Dror Tirosh", "Shahaf Nacson", "Alex Forshtat", "Kristof Gazso", "Tjaden Hess"},
+		Created:  "2021-09-29",
+		ABI:      `[{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation[]","name":"ops","type":"tuple[]"},{"internalType":"address","name":"beneficiary","type":"address"}],"name":"handleOps","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation[]","name":"userOps","type":"tuple[]"},{"internalType":"address","name":"aggregator","type":"address"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct UserOpsPerAggregator[]","name":"opsPerAggregator","type":"tuple[]"},{"internalType":"address","name":"beneficiary","type":"address"}],"name":"handleAggregatedOps","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"}],"name":"getUserOpHash","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes","name":"initCode","type":"bytes"}],"name":"getSenderAddress","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint192","name":"key","type":"uint192"}],"name":"getNonce","outputs":[{"internalType":"uint256","name":"nonce","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint192","name":"key","type":"uint192"}],"name":"incrementNonce","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"delegateAndRevert","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"depositTo","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"getDepositInfo","outputs":[{"components":[{"internalType":"uint256","name":"deposit","type":"uint256"},{"internalType":"bool","name":"staked","type":"bool"},{"internalType":"uint112","name":"stake","type":"uint112"},{"internalType":"uint32","name":"unstakeDelaySec","type":"uint32"},{"internalType":"uint48","name":"withdrawTime","type":"uint48"}],"internalType":"struct DepositInfo","name":"info","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint32","name":"unstakeDelaySec","type":"uint32"}],"name":"addStake","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"unlockStake","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"withdrawAddress","type":"address"}],"name":"withdrawStake","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"withdrawAddress","type":"address"},{"internalType":"uint256","name":"withdrawAmount","type":"uint256"}],"name":"withdrawTo","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"paymaster","type":"address"},{"indexed":false,"internalType":"uint256","name":"nonce","type":"uint256"},{"indexed":false,"internalType":"bool","name":"success","type":"bool"},{"indexed":false,"internalType":"uint256","name":"actualGasCost","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"actualGasUsed","type":"uint256"}],"name":"UserOperationEvent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"address","name":"factory","type":"address"},{"indexed":false,"internalType":"address","name":"paymaster","type":"address"}],"name":"AccountDeployed","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"nonce","type":"uint256"},{"indexed":false,"internalType":"bytes","name":"revertReason","type":"bytes"}],"name":"UserOperationRevertReason","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"nonce","type":"uint256"},{"indexed":false,"internalType":"bytes","name":"revertReason","type":"bytes"}],"name":"PostOpRevertReason","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"nonce","type":"uint256"}],"name":"UserOperationPrefundTooLow","type":"event"},{"anonymous":false,"inputs":[],"name":"BeforeExecution","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"aggregator","type":"address"}],"name":"SignatureAggregatorChanged","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":false,"internalType":"uint256","name":"totalDeposit","type":"uint256"}],"name":"Deposited","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":false,"internalType":"address","name":"withdrawAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Withdrawn","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":false,"internalType":"uint256","name":"totalStaked","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"unstakeDelaySec","type":"uint256"}],"name":"StakeLocked","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":false,"internalType":"uint256","name":"withdrawTime","type":"uint256"}],"name":"StakeUnlocked","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"account","type":"address"},{"indexed":false,"internalType":"address","name":"withdrawAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"StakeWithdrawn","type":"event"},{"inputs":[{"internalType":"uint256","name":"opIndex","type":"uint256"},{"internalType":"string","name":"reason","type":"string"}],"name":"FailedOp","type":"error"},{"inputs":[{"internalType":"uint256","name":"opIndex","type":"uint256"},{"internalType":"string","name":"reason","type":"string"},{"internalType":"bytes","name":"inner","type":"bytes"}],"name":"FailedOpWithRevert","type":"error"},{"inputs":[{"internalType":"bytes","name":"returnData","type":"bytes"}],"name":"PostOpReverted","type":"error"},{"inputs":[{"internalType":"address","name":"aggregator","type":"address"}],"name":"SignatureValidationFailed","type":"error"},{"inputs":[{"internalType":"address","name":"sender","type":"address"}],"name":"SenderAddressResult","type":"error"},{"inputs":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"ret","type":"bytes"}],"name":"DelegateAndRevert","type":"error"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("handleOps", []shared.Input{{Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("handleAggregatedOps", []shared.Input{{Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "userOps", Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Name: "aggregator", Type: shared.TypeAddress}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("getUserOpHash", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}}, []shared.Output{{Type: shared.TypeBytes32}}),
+			shared.NewFunction("getSenderAddress", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("getNonce", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint192}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("incrementNonce", []shared.Input{{Type: shared.TypeUint192}}, nil),
+			shared.NewFunction("delegateAndRevert", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("depositTo", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("getDepositInfo", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "deposit", Type: shared.TypeUint256}, {Name: "staked", Type: shared.TypeBool}, {Name: "stake", Type: shared.TypeUint112}, {Name: "unstakeDelaySec", Type: shared.TypeUint32}, {Name: "withdrawTime", Type: shared.TypeUint48}}}}),
+			shared.NewFunction("addStake", []shared.Input{{Type: shared.TypeUint32}}, nil),
+			shared.NewFunction("unlockStake", nil, nil),
+			shared.NewFunction("withdrawStake", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("withdrawTo", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("UserOperationEvent", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("AccountDeployed", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, nil),
+			shared.NewEvent("UserOperationRevertReason", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewEvent("PostOpRevertReason", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, nil),
+			shared.NewEvent("UserOperationPrefundTooLow", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("BeforeExecution", nil, nil),
+			shared.NewEvent("SignatureAggregatorChanged", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("Deposited", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Withdrawn", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("StakeLocked", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("StakeUnlocked", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("StakeWithdrawn", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+		},
+		Errors: []shared.Error{
+			shared.NewError("FailedOp", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeString}}),
+			shared.NewError("FailedOpWithRevert", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeString}, {Type: shared.TypeBytes}}),
+			shared.NewError("PostOpReverted", []shared.Input{{Type: shared.TypeBytes}}),
+			shared.NewError("SignatureValidationFailed", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("SenderAddressResult", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("DelegateAndRevert", []shared.Input{{Type: shared.TypeBool}, {Type: shared.TypeBytes}}),
+		},
+	},
+	ERC4337ACCOUNT: {
+		Name:     "ERC-4337 Account",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4337",
+		Type:     ERC4337ACCOUNT,
+		EIP:      4337,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction"},
+		Authors:  []string{"Vitalik Buterin", "Yoav Weiss", "Dror Tirosh", "Shahaf Nacson", "Alex Forshtat", "Kristof Gazso", "Tjaden Hess"},
+		Created:  "2021-09-29",
+		ABI:      `[{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"},{"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"internalType":"uint256","name":"missingAccountFunds","type":"uint256"}],"name":"validateUserOp","outputs":[{"internalType":"uint256","name":"validationData","type":"uint256"}],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("validateUserOp", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+	},
+	ERC4337PAYMASTER: {
+		Name:     "ERC-4337 Paymaster",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4337",
+		Type:     ERC4337PAYMASTER,
+		EIP:      4337,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction"},
+		Authors:  []string{"Vitalik Buterin", "Yoav Weiss", "Dror Tirosh", "Shahaf Nacson", "Alex Forshtat", "Kristof Gazso", "Tjaden Hess"},
+		Created:  "2021-09-29",
+		ABI:      `[{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"},{"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"internalType":"uint256","name":"maxCost","type":"uint256"}],"name":"validatePaymasterUserOp","outputs":[{"internalType":"bytes","name":"context","type":"bytes"},{"internalType":"uint256","name":"validationData","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint8","name":"mode","type":"uint8"},{"internalType":"bytes","name":"context","type":"bytes"},{"internalType":"uint256","name":"actualGasCost","type":"uint256"},{"internalType":"uint256","name":"actualUserOpFeePerGas","type":"uint256"}],"name":"postOp","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("validatePaymasterUserOp", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBytes}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("postOp", []shared.Input{{Type: shared.TypeUint8}, {Type: shared.TypeBytes}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	ERC4337AGGREGATOR: {
+		Name:     "ERC-4337 Signature Aggregator",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4337",
+		Type:     ERC4337AGGREGATOR,
+		EIP:      4337,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction"},
+		Authors:  []string{"Vitalik Buterin", "Yoav Weiss", "Dror Tirosh", "Shahaf Nacson", "Alex Forshtat", "Kristof Gazso", "Tjaden Hess"},
+		Created:  "2021-09-29",
+		ABI:      `[{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation[]","name":"userOps","type":"tuple[]"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"validateSignatures","outputs":[],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"}],"name":"validateUserOpSignature","outputs":[{"internalType":"bytes","name":"sigForUserOp","type":"bytes"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation[]","name":"userOps","type":"tuple[]"}],"name":"aggregateSignatures","outputs":[{"internalType":"bytes","name":"aggregatedSignature","type":"bytes"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("validateSignatures", []shared.Input{{Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("validateUserOpSignature", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}}, []shared.Output{{Type: shared.TypeBytes}}),
+			shared.NewFunction("aggregateSignatures", []shared.Input{{Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}}, []shared.Output{{Type: shared.TypeBytes}}),
+		},
+	},
+	ERC7579ACCOUNT: {
+		Name:     "ERC-7579 Minimal Modular Smart Account",
+		Url:      "https://eips.ethereum.org/EIPS/eip-7579",
+		Type:     ERC7579ACCOUNT,
+		EIP:      7579,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction", "modular"},
+		Authors:  []string{"zeroknots", "Konrad Kopp", "Taek Lee", "Fil Makarov", "Elim Poon", "Alex Forshtat"},
+		Requires: []shared.Standard{ERC165, ERC4337ACCOUNT},
+		Created:  "2023-12-14",
+		ABI:      `[{"inputs":[{"internalType":"bytes32","name":"mode","type":"bytes32"},{"internalType":"bytes","name":"executionCalldata","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"bytes32","name":"mode","type":"bytes32"},{"internalType":"bytes","name":"executionCalldata","type":"bytes"}],"name":"executeFromExecutor","outputs":[{"internalType":"bytes[]","name":"returnData","type":"bytes[]"}],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"accountId","outputs":[{"internalType":"string","name":"accountImplementationId","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes32","name":"encodedMode","type":"bytes32"}],"name":"supportsExecutionMode","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"}],"name":"supportsModule","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"},{"internalType":"address","name":"module","type":"address"},{"internalType":"bytes","name":"initData","type":"bytes"}],"name":"installModule","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"},{"internalType":"address","name":"module","type":"address"},{"internalType":"bytes","name":"deInitData","type":"bytes"}],"name":"uninstallModule","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"},{"internalType":"address","name":"module","type":"address"},{"internalType":"bytes","name":"additionalContext","type":"bytes"}],"name":"isModuleInstalled","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"isValidSignature","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"moduleTypeId","type":"uint256"},{"indexed":false,"internalType":"address","name":"module","type":"address"}],"name":"ModuleInstalled","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"moduleTypeId","type":"uint256"},{"indexed":false,"internalType":"address","name":"module","type":"address"}],"name":"ModuleUninstalled","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("execute", []shared.Input{{Type: shared.TypeBytes32}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("executeFromExecutor", []shared.Input{{Type: shared.TypeBytes32}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytesArray}}),
+			shared.NewFunction("accountId", nil, []shared.Output{{Type: shared.TypeString}}),
+			shared.NewFunction("supportsExecutionMode", []shared.Input{{Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("supportsModule", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("installModule", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("uninstallModule", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("isModuleInstalled", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("isValidSignature", []shared.Input{{Type: shared.TypeBytes32}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("ModuleInstalled", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+			shared.NewEvent("ModuleUninstalled", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+		},
+	},
+	ERC7579MODULE: {
+		Name:     "ERC-7579 Module",
+		Url:      "https://eips.ethereum.org/EIPS/eip-7579",
+		Type:     ERC7579MODULE,
+		EIP:      7579,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction", "modular"},
+		Authors:  []string{"zeroknots", "Konrad Kopp", "Taek Lee", "Fil Makarov", "Elim Poon", "Alex Forshtat"},
+		Requires: []shared.Standard{ERC7579ACCOUNT},
+		Created:  "2023-12-14",
+		ABI:      `[{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onInstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onUninstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"}],"name":"isModuleType","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("onInstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("onUninstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("isModuleType", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+		},
+	},
+	ERC7579VALIDATOR: {
+		Name:     "ERC-7579 Validator Module",
+		Url:      "https://eips.ethereum.org/EIPS/eip-7579",
+		Type:     ERC7579VALIDATOR,
+		EIP:      7579,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction", "modular"},
+		Authors:  []string{"zeroknots", "Konrad Kopp", "Taek Lee", "Fil Makarov", "Elim Poon", "Alex Forshtat"},
+		Requires: []shared.Standard{ERC7579ACCOUNT},
+		Created:  "2023-12-14",
+		ABI:      `[{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onInstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onUninstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"}],"name":"isModuleType","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"initCode","type":"bytes"},{"internalType":"bytes","name":"callData","type":"bytes"},{"internalType":"bytes32","name":"accountGasLimits","type":"bytes32"},{"internalType":"uint256","name":"preVerificationGas","type":"uint256"},{"internalType":"bytes32","name":"gasFees","type":"bytes32"},{"internalType":"bytes","name":"paymasterAndData","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"internalType":"struct PackedUserOperation","name":"userOp","type":"tuple"},{"internalType":"bytes32","name":"userOpHash","type":"bytes32"}],"name":"validateUserOp","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"isValidSignatureWithSender","outputs":[{"internalType":"bytes4","name":"","type":"bytes4"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("onInstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("onUninstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("isModuleType", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("validateUserOp", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "sender", Type: shared.TypeAddress}, {Name: "nonce", Type: shared.TypeUint256}, {Name: "initCode", Type: shared.TypeBytes}, {Name: "callData", Type: shared.TypeBytes}, {Name: "accountGasLimits", Type: shared.TypeBytes32}, {Name: "preVerificationGas", Type: shared.TypeUint256}, {Name: "gasFees", Type: shared.TypeBytes32}, {Name: "paymasterAndData", Type: shared.TypeBytes}, {Name: "signature", Type: shared.TypeBytes}}}, {Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("isValidSignatureWithSender", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBytes32}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes4}}),
+		},
+	},
+	ERC7579HOOK: {
+		Name:     "ERC-7579 Hook Module",
+		Url:      "https://eips.ethereum.org/EIPS/eip-7579",
+		Type:     ERC7579HOOK,
+		EIP:      7579,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryAccount,
+		Tags:     []string{"account-abstraction", "modular"},
+		Authors:  []string{"zeroknots", "Konrad Kopp", "Taek Lee", "Fil Makarov", "Elim Poon", "Alex Forshtat"},
+		Requires: []shared.Standard{ERC7579ACCOUNT},
+		Created:  "2023-12-14",
+		ABI:      `[{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onInstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onUninstall","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"moduleTypeId","type":"uint256"}],"name":"isModuleType","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"msgSender","type":"address"},{"internalType":"uint256","name":"msgValue","type":"uint256"},{"internalType":"bytes","name":"msgData","type":"bytes"}],"name":"preCheck","outputs":[{"internalType":"bytes","name":"hookData","type":"bytes"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes","name":"hookData","type":"bytes"}],"name":"postCheck","outputs":[],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("onInstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("onUninstall", []shared.Input{{Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("isModuleType", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("preCheck", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes}}),
+			shared.NewFunction("postCheck", []shared.Input{{Type: shared.TypeBytes}}, nil),
+		},
+	},
+	ERC2309: {
+		Name:     "ERC-2309 Consecutive Transfer Extension",
+		Url:      "https://eips.ethereum.org/EIPS/eip-2309",
+		Type:     ERC2309,
+		EIP:      2309,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft"},
+		Authors:  []string{"Sean Papanikolas"},
+		Requires: []shared.Standard{ERC721},
+		Extends:  []shared.Standard{ERC721},
+		Created:  "2019-10-08",
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"fromTokenId","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"toTokenId","type":"uint256"},{"indexed":true,"internalType":"address","name":"fromAddress","type":"address"},{"indexed":true,"internalType":"address","name":"toAddress","type":"address"}],"name":"ConsecutiveTransfer","type":"event"}]`,
+		Events: []shared.Event{
+			shared.NewEvent("ConsecutiveTransfer", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+	},
+	ERC2981: {
+		Name:     "ERC-2981 NFT Royalty Standard",
+		Url:      "https://eips.ethereum.org/EIPS/eip-2981",
+		Type:     ERC2981,
+		EIP:      2981,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft", "royalties"},
+		Authors:  []string{"Zach Burks", "James Morgan", "Blaine Malone", "James Seibel"},
+		Requires: []shared.Standard{ERC165},
+		Extends:  []shared.Standard{ERC721, ERC1155},
+		Created:  "2020-09-15",
+		// ABI, functions, events and errors are generated into the directory/openzeppelin package.
+	},
+	ERC4906: {
+		Name:     "ERC-4906 EIP-721 Metadata Update Extension",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4906",
+		Type:     ERC4906,
+		EIP:      4906,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft", "metadata"},
+		Authors:  []string{"Anders", "Lance", "Shrug"},
+		Requires: []shared.Standard{ERC165, ERC721},
+		Extends:  []shared.Standard{ERC721},
+		Created:  "2022-03-29",
+		ABI:      `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"_tokenId","type":"uint256"}],"name":"MetadataUpdate","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"_fromTokenId","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"_toTokenId","type":"uint256"}],"name":"BatchMetadataUpdate","type":"event"}]`,
+		Events: []shared.Event{
+			shared.NewEvent("MetadataUpdate", []shared.Input{{Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("BatchMetadataUpdate", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	ERC4907: {
+		Name:     "ERC-4907 Rental NFT, an Extension of EIP-721",
+		Url:      "https://eips.ethereum.org/EIPS/eip-4907",
+		Type:     ERC4907,
+		EIP:      4907,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft", "rental"},
+		Authors:  []string{"Anders", "Lance", "Shrug"},
+		Requires: []shared.Standard{ERC165, ERC721},
+		Extends:  []shared.Standard{ERC721},
+		Created:  "2022-03-11",
+		ABI:      `[{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"user","type":"address"},{"internalType":"uint64","name":"expires","type":"uint64"}],"name":"setUser","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"userOf","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"userExpires","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"uint64","name":"expires","type":"uint64"}],"name":"UpdateUser","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("setUser", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint64}}, nil),
+			shared.NewFunction("userOf", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("userExpires", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("UpdateUser", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint64}}, nil),
+		},
+	},
+	ERC5192: {
+		Name:     "ERC-5192 Minimal Soulbound NFTs",
+		Url:      "https://eips.ethereum.org/EIPS/eip-5192",
+		Type:     ERC5192,
+		EIP:      5192,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft", "soulbound"},
+		Authors:  []string{"Tim Daubenschütz", "Anders"},
+		Requires: []shared.Standard{ERC165, ERC721},
+		Extends:  []shared.Standard{ERC721},
+		Created:  "2022-07-01",
+		ABI:      `[{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"locked","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Locked","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Unlocked","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("locked", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Locked", []shared.Input{{Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Unlocked", []shared.Input{{Type: shared.TypeUint256}}, nil),
+		},
+	},
+	ERC721A: {
+		Name:     "ERC721A Gas Efficient Batch Minting",
+		Url:      "https://www.erc721a.org",
+		Type:     ERC721A,
+		Category: shared.CategoryToken,
+		Tags:     []string{"nft", "batch-mint"},
+		Authors:  []string{"Chiru Labs"},
+		Requires: []shared.Standard{ERC721, ERC2309},
+		Extends:  []shared.Standard{ERC721},
+		ABI:      `[{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"fromTokenId","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"toTokenId","type":"uint256"},{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"}],"name":"ConsecutiveTransfer","type":"event"},{"inputs":[],"name":"ApprovalCallerNotOwnerNorApproved","type":"error"},{"inputs":[],"name":"ApprovalQueryForNonexistentToken","type":"error"},{"inputs":[],"name":"BalanceQueryForZeroAddress","type":"error"},{"inputs":[],"name":"MintToZeroAddress","type":"error"},{"inputs":[],"name":"MintZeroQuantity","type":"error"},{"inputs":[],"name":"OwnerQueryForNonexistentToken","type":"error"},{"inputs":[],"name":"TransferCallerNotOwnerNorApproved","type":"error"},{"inputs":[],"name":"TransferFromIncorrectOwner","type":"error"},{"inputs":[],"name":"TransferToNonERC721ReceiverImplementer","type":"error"},{"inputs":[],"name":"TransferToZeroAddress","type":"error"},{"inputs":[],"name":"URIQueryForNonexistentToken","type":"error"},{"inputs":[],"name":"MintERC2309QuantityExceedsLimit","type":"error"},{"inputs":[],"name":"OwnershipNotInitializedForExtraData","type":"error"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("totalSupply", nil, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("ConsecutiveTransfer", []shared.Input{{Type: shared.TypeUint256, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+		Errors: []shared.Error{
+			shared.NewError("ApprovalCallerNotOwnerNorApproved", nil),
+			shared.NewError("ApprovalQueryForNonexistentToken", nil),
+			shared.NewError("BalanceQueryForZeroAddress", nil),
+			shared.NewError("MintToZeroAddress", nil),
+			shared.NewError("MintZeroQuantity", nil),
+			shared.NewError("OwnerQueryForNonexistentToken", nil),
+			shared.NewError("TransferCallerNotOwnerNorApproved", nil),
+			shared.NewError("TransferFromIncorrectOwner", nil),
+			shared.NewError("TransferToNonERC721ReceiverImplementer", nil),
+			shared.NewError("TransferToZeroAddress", nil),
+			shared.NewError("URIQueryForNonexistentToken", nil),
+			shared.NewError("MintERC2309QuantityExceedsLimit", nil),
+			shared.NewError("OwnershipNotInitializedForExtraData", nil),
+		},
+	},
+	ERC3156: {
+		Name:     "ERC-3156 Flash Loans Lender",
+		Url:      "https://eips.ethereum.org/EIPS/eip-3156",
+		Type:     ERC3156,
+		EIP:      3156,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"flash-loan"},
+		Authors:  []string{"Alberto Cuesta Cañada", "Fiona Kobayashi", "fubuloubu", "Austin Williams"},
+		Created:  "2020-11-15",
+		ABI:      `[{"inputs":[{"internalType":"address","name":"token","type":"address"}],"name":"maxFlashLoan","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"flashFee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"receiver","type":"address"},{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"flashLoan","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("maxFlashLoan", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("flashFee", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("flashLoan", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBool}}),
+		},
+	},
+	ERC3156BORROWER: {
+		Name:     "ERC-3156 Flash Loans Borrower",
+		Url:      "https://eips.ethereum.org/EIPS/eip-3156",
+		Type:     ERC3156BORROWER,
+		EIP:      3156,
+		Status:   shared.StatusFinal,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"flash-loan"},
+		Authors:  []string{"Alberto Cuesta Cañada", "Fiona Kobayashi", "fubuloubu", "Austin Williams"},
+		Created:  "2020-11-15",
+		ABI:      `[{"inputs":[{"internalType":"address","name":"initiator","type":"address"},{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"fee","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"onFlashLoan","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"nonpayable","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("onFlashLoan", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeBytes32}}),
+		},
+	},
+	AAVEV2POOL: {
+		Name:     "Aave V2 Lending Pool",
+		Url:      "https://docs.aave.com/developers/v/2.0/the-core-protocol/lendingpool",
+		Type:     AAVEV2POOL,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"lending", "flash-loan", "aave"},
+		Authors:  []string{"Aave"},
+		ABI:      `[{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"deposit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"to","type":"address"}],"name":"withdraw","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"},{"internalType":"uint16","name":"referralCode","type":"uint16"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"borrow","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"rateMode","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"repay","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"rateMode","type":"uint256"}],"name":"swapBorrowRateMode","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"address","name":"user","type":"address"}],"name":"rebalanceStableBorrowRate","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"bool","name":"useAsCollateral","type":"bool"}],"name":"setUserUseReserveAsCollateral","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"collateralAsset","type":"address"},{"internalType":"address","name":"debtAsset","type":"address"},{"internalType":"address","name":"user","type":"address"},{"internalType":"uint256","name":"debtToCover","type":"uint256"},{"internalType":"bool","name":"receiveAToken","type":"bool"}],"name":"liquidationCall","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"receiverAddress","type":"address"},{"internalType":"address[]","name":"assets","type":"address[]"},{"internalType":"uint256[]","name":"amounts","type":"uint256[]"},{"internalType":"uint256[]","name":"modes","type":"uint256[]"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"bytes","name":"params","type":"bytes"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"flashLoan","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"user","type":"address"}],"name":"getUserAccountData","outputs":[{"internalType":"uint256","name":"totalCollateralETH","type":"uint256"},{"internalType":"uint256","name":"totalDebtETH","type":"uint256"},{"internalType":"uint256","name":"availableBorrowsETH","type":"uint256"},{"internalType":"uint256","name":"currentLiquidationThreshold","type":"uint256"},{"internalType":"uint256","name":"ltv","type":"uint256"},{"internalType":"uint256","name":"healthFactor","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getReserveData","outputs":[{"components":[{"components":[{"internalType":"uint256","name":"data","type":"uint256"}],"internalType":"struct ReserveConfigurationMap","name":"configuration","type":"tuple"},{"internalType":"uint128","name":"liquidityIndex","type":"uint128"},{"internalType":"uint128","name":"variableBorrowIndex","type":"uint128"},{"internalType":"uint128","name":"currentLiquidityRate","type":"uint128"},{"internalType":"uint128","name":"currentVariableBorrowRate","type":"uint128"},{"internalType":"uint128","name":"currentStableBorrowRate","type":"uint128"},{"internalType":"uint40","name":"lastUpdateTimestamp","type":"uint40"},{"internalType":"address","name":"aTokenAddress","type":"address"},{"internalType":"address","name":"stableDebtTokenAddress","type":"address"},{"internalType":"address","name":"variableDebtTokenAddress","type":"address"},{"internalType":"address","name":"interestRateStrategyAddress","type":"address"},{"internalType":"uint8","name":"id","type":"uint8"}],"internalType":"struct ReserveData","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getReserveNormalizedIncome","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getReserveNormalizedVariableDebt","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getReservesList","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getAddressesProvider","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"paused","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referral","type":"uint16"}],"name":"Deposit","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Withdraw","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"borrowRateMode","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"borrowRate","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referral","type":"uint16"}],"name":"Borrow","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"repayer","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Repay","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"uint256","name":"rateMode","type":"uint256"}],"name":"Swap","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"}],"name":"ReserveUsedAsCollateralEnabled","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"}],"name":"ReserveUsedAsCollateralDisabled","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"}],"name":"RebalanceStableBorrowRate","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"target","type":"address"},{"indexed":true,"internalType":"address","name":"initiator","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"premium","type":"uint256"},{"indexed":false,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"FlashLoan","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"collateralAsset","type":"address"},{"indexed":true,"internalType":"address","name":"debtAsset","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"uint256","name":"debtToCover","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"liquidatedCollateralAmount","type":"uint256"},{"indexed":false,"internalType":"address","name":"liquidator","type":"address"},{"indexed":false,"internalType":"bool","name":"receiveAToken","type":"bool"}],"name":"LiquidationCall","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("deposit", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("withdraw", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("repay", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("swapBorrowRateMode", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("rebalanceStableBorrowRate", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("setUserUseReserveAsCollateral", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBool}}, nil),
+			shared.NewFunction("liquidationCall", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}}, nil),
+			shared.NewFunction("flashLoan", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddressArray}, {Type: shared.TypeUint256Array}, {Type: shared.TypeUint256Array}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("getUserAccountData", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("getReserveData", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "configuration", Type: shared.TypeTuple, Components: []shared.Input{{Name: "data", Type: shared.TypeUint256}}}, {Name: "liquidityIndex", Type: shared.TypeUint128}, {Name: "variableBorrowIndex", Type: shared.TypeUint128}, {Name: "currentLiquidityRate", Type: shared.TypeUint128}, {Name: "currentVariableBorrowRate", Type: shared.TypeUint128}, {Name: "currentStableBorrowRate", Type: shared.TypeUint128}, {Name: "lastUpdateTimestamp", Type: shared.TypeUint40}, {Name: "aTokenAddress", Type: shared.TypeAddress}, {Name: "stableDebtTokenAddress", Type: shared.TypeAddress}, {Name: "variableDebtTokenAddress", Type: shared.TypeAddress}, {Name: "interestRateStrategyAddress", Type: shared.TypeAddress}, {Name: "id", Type: shared.TypeUint8}}}}),
+			shared.NewFunction("getReserveNormalizedIncome", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getReserveNormalizedVariableDebt", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getReservesList", nil, []shared.Output{{Type: shared.TypeAddressArray}}),
+			shared.NewFunction("getAddressesProvider", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("paused", nil, []shared.Output{{Type: shared.TypeBool}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Deposit", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16, Indexed: true}}, nil),
+			shared.NewEvent("Withdraw", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Borrow", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16, Indexed: true}}, nil),
+			shared.NewEvent("Repay", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Swap", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("ReserveUsedAsCollateralEnabled", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("ReserveUsedAsCollateralDisabled", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("RebalanceStableBorrowRate", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("FlashLoan", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16}}, nil),
+			shared.NewEvent("LiquidationCall", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBool}}, nil),
+		},
+	},
+	AAVEV3POOL: {
+		Name:     "Aave V3 Pool",
+		Url:      "https://docs.aave.com/developers/core-contracts/pool",
+		Type:     AAVEV3POOL,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"lending", "flash-loan", "aave"},
+		Authors:  []string{"Aave"},
+		ABI:      `[{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"supply","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"uint16","name":"referralCode","type":"uint16"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint8","name":"permitV","type":"uint8"},{"internalType":"bytes32","name":"permitR","type":"bytes32"},{"internalType":"bytes32","name":"permitS","type":"bytes32"}],"name":"supplyWithPermit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"to","type":"address"}],"name":"withdraw","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"},{"internalType":"uint16","name":"referralCode","type":"uint16"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"borrow","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"},{"internalType":"address","name":"onBehalfOf","type":"address"}],"name":"repay","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"interestRateMode","type":"uint256"}],"name":"repayWithATokens","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"bool","name":"useAsCollateral","type":"bool"}],"name":"setUserUseReserveAsCollateral","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"collateralAsset","type":"address"},{"internalType":"address","name":"debtAsset","type":"address"},{"internalType":"address","name":"user","type":"address"},{"internalType":"uint256","name":"debtToCover","type":"uint256"},{"internalType":"bool","name":"receiveAToken","type":"bool"}],"name":"liquidationCall","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"receiverAddress","type":"address"},{"internalType":"address[]","name":"assets","type":"address[]"},{"internalType":"uint256[]","name":"amounts","type":"uint256[]"},{"internalType":"uint256[]","name":"interestRateModes","type":"uint256[]"},{"internalType":"address","name":"onBehalfOf","type":"address"},{"internalType":"bytes","name":"params","type":"bytes"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"flashLoan","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"receiverAddress","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"params","type":"bytes"},{"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"flashLoanSimple","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"user","type":"address"}],"name":"getUserAccountData","outputs":[{"internalType":"uint256","name":"totalCollateralBase","type":"uint256"},{"internalType":"uint256","name":"totalDebtBase","type":"uint256"},{"internalType":"uint256","name":"availableBorrowsBase","type":"uint256"},{"internalType":"uint256","name":"currentLiquidationThreshold","type":"uint256"},{"internalType":"uint256","name":"ltv","type":"uint256"},{"internalType":"uint256","name":"healthFactor","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getReserveNormalizedIncome","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getReserveNormalizedVariableDebt","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getReservesList","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint8","name":"categoryId","type":"uint8"}],"name":"setUserEMode","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"user","type":"address"}],"name":"getUserEMode","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address[]","name":"assets","type":"address[]"}],"name":"mintToTreasury","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"ADDRESSES_PROVIDER","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"FLASHLOAN_PREMIUM_TOTAL","outputs":[{"internalType":"uint128","name":"","type":"uint128"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"Supply","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Withdraw","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":false,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"onBehalfOf","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint8","name":"interestRateMode","type":"uint8"},{"indexed":false,"internalType":"uint256","name":"borrowRate","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"Borrow","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":true,"internalType":"address","name":"repayer","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"bool","name":"useATokens","type":"bool"}],"name":"Repay","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"}],"name":"ReserveUsedAsCollateralEnabled","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"reserve","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"}],"name":"ReserveUsedAsCollateralDisabled","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"target","type":"address"},{"indexed":false,"internalType":"address","name":"initiator","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint8","name":"interestRateMode","type":"uint8"},{"indexed":false,"internalType":"uint256","name":"premium","type":"uint256"},{"indexed":true,"internalType":"uint16","name":"referralCode","type":"uint16"}],"name":"FlashLoan","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"collateralAsset","type":"address"},{"indexed":true,"internalType":"address","name":"debtAsset","type":"address"},{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"uint256","name":"debtToCover","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"liquidatedCollateralAmount","type":"uint256"},{"indexed":false,"internalType":"address","name":"liquidator","type":"address"},{"indexed":false,"internalType":"bool","name":"receiveAToken","type":"bool"}],"name":"LiquidationCall","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"user","type":"address"},{"indexed":false,"internalType":"uint8","name":"categoryId","type":"uint8"}],"name":"UserEModeSet","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("supply", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("supplyWithPermit", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint16}, {Type: shared.TypeUint256}, {Type: shared.TypeUint8}, {Type: shared.TypeBytes32}, {Type: shared.TypeBytes32}}, nil),
+			shared.NewFunction("withdraw", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("repay", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("repayWithATokens", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("setUserUseReserveAsCollateral", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeBool}}, nil),
+			shared.NewFunction("liquidationCall", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}}, nil),
+			shared.NewFunction("flashLoan", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddressArray}, {Type: shared.TypeUint256Array}, {Type: shared.TypeUint256Array}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("flashLoanSimple", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeBytes}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("getUserAccountData", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("getReserveNormalizedIncome", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getReserveNormalizedVariableDebt", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getReservesList", nil, []shared.Output{{Type: shared.TypeAddressArray}}),
+			shared.NewFunction("setUserEMode", []shared.Input{{Type: shared.TypeUint8}}, nil),
+			shared.NewFunction("getUserEMode", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("mintToTreasury", []shared.Input{{Type: shared.TypeAddressArray}}, nil),
+			shared.NewFunction("ADDRESSES_PROVIDER", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("FLASHLOAN_PREMIUM_TOTAL", nil, []shared.Output{{Type: shared.TypeUint128}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Supply", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16, Indexed: true}}, nil),
+			shared.NewEvent("Withdraw", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Borrow", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint8}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16, Indexed: true}}, nil),
+			shared.NewEvent("Repay", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeBool}}, nil),
+			shared.NewEvent("ReserveUsedAsCollateralEnabled", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("ReserveUsedAsCollateralDisabled", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("FlashLoan", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint8}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16, Indexed: true}}, nil),
+			shared.NewEvent("LiquidationCall", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeBool}}, nil),
+			shared.NewEvent("UserEModeSet", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint8}}, nil),
+		},
+	},
+	COMPOUNDV2CTOKEN: {
+		Name:     "Compound V2 cToken",
+		Url:      "https://docs.compound.finance/v2/ctokens/",
+		Type:     COMPOUNDV2CTOKEN,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"lending", "compound"},
+		Authors:  []string{"Compound Labs"},
+		Requires: []shared.Standard{ERC20},
+		ABI:      `[{"inputs":[{"internalType":"uint256","name":"mintAmount","type":"uint256"}],"name":"mint","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"redeemTokens","type":"uint256"}],"name":"redeem","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"redeemAmount","type":"uint256"}],"name":"redeemUnderlying","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"borrowAmount","type":"uint256"}],"name":"borrow","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"repayAmount","type":"uint256"}],"name":"repayBorrow","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"borrower","type":"address"},{"internalType":"uint256","name":"repayAmount","type":"uint256"}],"name":"repayBorrowBehalf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"borrower","type":"address"},{"internalType":"uint256","name":"repayAmount","type":"uint256"},{"internalType":"address","name":"cTokenCollateral","type":"address"}],"name":"liquidateBorrow","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"liquidator","type":"address"},{"internalType":"address","name":"borrower","type":"address"},{"internalType":"uint256","name":"seizeTokens","type":"uint256"}],"name":"seize","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"underlying","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"comptroller","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"isCToken","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"exchangeRateCurrent","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"exchangeRateStored","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOfUnderlying","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"borrowBalanceCurrent","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"borrowBalanceStored","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"getAccountSnapshot","outputs":[{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"supplyRatePerBlock","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"borrowRatePerBlock","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"totalBorrows","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"totalReserves","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getCash","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"accrueInterest","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"cashPrior","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"interestAccumulated","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"borrowIndex","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"totalBorrows","type":"uint256"}],"name":"AccrueInterest","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"minter","type":"address"},{"indexed":false,"internalType":"uint256","name":"mintAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"mintTokens","type":"uint256"}],"name":"Mint","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"redeemer","type":"address"},{"indexed":false,"internalType":"uint256","name":"redeemAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"redeemTokens","type":"uint256"}],"name":"Redeem","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"borrower","type":"address"},{"indexed":false,"internalType":"uint256","name":"borrowAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"accountBorrows","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"totalBorrows","type":"uint256"}],"name":"Borrow","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"payer","type":"address"},{"indexed":false,"internalType":"address","name":"borrower","type":"address"},{"indexed":false,"internalType":"uint256","name":"repayAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"accountBorrows","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"totalBorrows","type":"uint256"}],"name":"RepayBorrow","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"liquidator","type":"address"},{"indexed":false,"internalType":"address","name":"borrower","type":"address"},{"indexed":false,"internalType":"uint256","name":"repayAmount","type":"uint256"},{"indexed":false,"internalType":"address","name":"cTokenCollateral","type":"address"},{"indexed":false,"internalType":"uint256","name":"seizeTokens","type":"uint256"}],"name":"LiquidateBorrow","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("mint", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("redeem", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("redeemUnderlying", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrow", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("repayBorrow", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("repayBorrowBehalf", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("liquidateBorrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("seize", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("underlying", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("comptroller", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("isCToken", nil, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("exchangeRateCurrent", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("exchangeRateStored", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("balanceOfUnderlying", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrowBalanceCurrent", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrowBalanceStored", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getAccountSnapshot", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("supplyRatePerBlock", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("borrowRatePerBlock", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("totalBorrows", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("totalReserves", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getCash", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("accrueInterest", nil, []shared.Output{{Type: shared.TypeUint256}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("AccrueInterest", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Mint", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Redeem", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Borrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("RepayBorrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("LiquidateBorrow", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	COMPOUNDV3COMET: {
+		Name:     "Compound V3 Comet",
+		Url:      "https://docs.compound.finance/",
+		Type:     COMPOUNDV3COMET,
+		Category: shared.CategoryDefi,
+		Tags:     []string{"lending", "compound"},
+		Authors:  []string{"Compound Labs"},
+		ABI:      `[{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"supply","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"dst","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"supplyTo","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"dst","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"supplyFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"withdraw","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"withdrawTo","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"src","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"withdrawFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"absorber","type":"address"},{"internalType":"address[]","name":"accounts","type":"address[]"}],"name":"absorb","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"minAmount","type":"uint256"},{"internalType":"uint256","name":"baseAmount","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"}],"name":"buyCollateral","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"baseAmount","type":"uint256"}],"name":"quoteCollateral","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"isLiquidatable","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"isBorrowCollateralized","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"baseToken","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"borrowBalanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"},{"internalType":"address","name":"asset","type":"address"}],"name":"collateralBalanceOf","outputs":[{"internalType":"uint128","name":"","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint8","name":"i","type":"uint8"}],"name":"getAssetInfo","outputs":[{"components":[{"internalType":"uint8","name":"offset","type":"uint8"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"address","name":"priceFeed","type":"address"},{"internalType":"uint64","name":"scale","type":"uint64"},{"internalType":"uint64","name":"borrowCollateralFactor","type":"uint64"},{"internalType":"uint64","name":"liquidateCollateralFactor","type":"uint64"},{"internalType":"uint64","name":"liquidationFactor","type":"uint64"},{"internalType":"uint128","name":"supplyCap","type":"uint128"}],"internalType":"struct AssetInfo","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"asset","type":"address"}],"name":"getAssetInfoByAddress","outputs":[{"components":[{"internalType":"uint8","name":"offset","type":"uint8"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"address","name":"priceFeed","type":"address"},{"internalType":"uint64","name":"scale","type":"uint64"},{"internalType":"uint64","name":"borrowCollateralFactor","type":"uint64"},{"internalType":"uint64","name":"liquidateCollateralFactor","type":"uint64"},{"internalType":"uint64","name":"liquidationFactor","type":"uint64"},{"internalType":"uint128","name":"supplyCap","type":"uint128"}],"internalType":"struct AssetInfo","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"numAssets","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"priceFeed","type":"address"}],"name":"getPrice","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getUtilization","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"utilization","type":"uint256"}],"name":"getSupplyRate","outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"utilization","type":"uint256"}],"name":"getBorrowRate","outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getReserves","outputs":[{"internalType":"int256","name":"","type":"int256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"accrueAccount","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"dst","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Supply","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"src","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Withdraw","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"dst","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"SupplyCollateral","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"src","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"WithdrawCollateral","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"absorber","type":"address"},{"indexed":true,"internalType":"address","name":"borrower","type":"address"},{"indexed":false,"internalType":"uint256","name":"basePaidOut","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"usdValue","type":"uint256"}],"name":"AbsorbDebt","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"absorber","type":"address"},{"indexed":true,"internalType":"address","name":"borrower","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"collateralAbsorbed","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"usdValue","type":"uint256"}],"name":"AbsorbCollateral","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"buyer","type":"address"},{"indexed":true,"internalType":"address","name":"asset","type":"address"},{"indexed":false,"internalType":"uint256","name":"baseAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"collateralAmount","type":"uint256"}],"name":"BuyCollateral","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("supply", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("supplyTo", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("supplyFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("withdraw", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("withdrawTo", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("withdrawFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("absorb", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddressArray}}, nil),
+			shared.NewFunction("buyCollateral", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("quoteCollateral", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("isLiquidatable", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("isBorrowCollateralized", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("baseToken", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("borrowBalanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("collateralBalanceOf", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint128}}),
+			shared.NewFunction("getAssetInfo", []shared.Input{{Type: shared.TypeUint8}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "offset", Type: shared.TypeUint8}, {Name: "asset", Type: shared.TypeAddress}, {Name: "priceFeed", Type: shared.TypeAddress}, {Name: "scale", Type: shared.TypeUint64}, {Name: "borrowCollateralFactor", Type: shared.TypeUint64}, {Name: "liquidateCollateralFactor", Type: shared.TypeUint64}, {Name: "liquidationFactor", Type: shared.TypeUint64}, {Name: "supplyCap", Type: shared.TypeUint128}}}}),
+			shared.NewFunction("getAssetInfoByAddress", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "offset", Type: shared.TypeUint8}, {Name: "asset", Type: shared.TypeAddress}, {Name: "priceFeed", Type: shared.TypeAddress}, {Name: "scale", Type: shared.TypeUint64}, {Name: "borrowCollateralFactor", Type: shared.TypeUint64}, {Name: "liquidateCollateralFactor", Type: shared.TypeUint64}, {Name: "liquidationFactor", Type: shared.TypeUint64}, {Name: "supplyCap", Type: shared.TypeUint128}}}}),
+			shared.NewFunction("numAssets", nil, []shared.Output{{Type: shared.TypeUint8}}),
+			shared.NewFunction("getPrice", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getUtilization", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getSupplyRate", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint64}}),
+			shared.NewFunction("getBorrowRate", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint64}}),
+			shared.NewFunction("getReserves", nil, []shared.Output{{Type: shared.TypeInt256}}),
+			shared.NewFunction("accrueAccount", []shared.Input{{Type: shared.TypeAddress}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Supply", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Withdraw", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("SupplyCollateral", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("WithdrawCollateral", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("AbsorbDebt", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("AbsorbCollateral", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("BuyCollateral", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+		},
+	},
+	ERC7281: {
+		Name:     "ERC-7281 Sovereign Bridged Token (xERC20)",
+		Url:      "https://ethereum-magicians.org/t/erc-7281-sovereign-bridged-tokens/14979",
+		Type:     ERC7281,
+		EIP:      7281,
+		Status:   shared.StatusDraft,
+		Category: shared.CategoryBridging,
+		Tags:     []string{"cross-chain", "fungible"},
+		Authors:  []string{"Arjun Bhuptani"},
+		Requires: []shared.Standard{ERC20},
+		Extends:  []shared.Standard{ERC20},
+		Created:  "2023-06-27",
+		ABI:      `[{"inputs":[{"internalType":"address","name":"lockbox","type":"address"}],"name":"setLockbox","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"bridge","type":"address"},{"internalType":"uint256","name":"mintingLimit","type":"uint256"},{"internalType":"uint256","name":"burningLimit","type":"uint256"}],"name":"setLimits","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"bridge","type":"address"}],"name":"mintingMaxLimitOf","outputs":[{"internalType":"uint256","name":"limit","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"bridge","type":"address"}],"name":"burningMaxLimitOf","outputs":[{"internalType":"uint256","name":"limit","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"bridge","type":"address"}],"name":"mintingCurrentLimitOf","outputs":[{"internalType":"uint256","name":"limit","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"bridge","type":"address"}],"name":"burningCurrentLimitOf","outputs":[{"internalType":"uint256","name":"limit","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"user","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"user","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"burn","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"lockbox","type":"address"}],"name":"LockboxSet","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"mintingLimit","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"burningLimit","type":"uint256"},{"indexed":true,"internalType":"address","name":"bridge","type":"address"}],"name":"BridgeLimitsSet","type":"event"},{"inputs":[],"name":"IXERC20_NotHighEnoughLimits","type":"error"},{"inputs":[],"name":"IXERC20_NotFactory","type":"error"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("setLockbox", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("setLimits", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("mintingMaxLimitOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("burningMaxLimitOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("mintingCurrentLimitOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("burningCurrentLimitOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("mint", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("burn", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("LockboxSet", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewEvent("BridgeLimitsSet", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+		Errors: []shared.Error{
+			shared.NewError("IXERC20_NotHighEnoughLimits", nil),
+			shared.NewError("IXERC20_NotFactory", nil),
+		},
+	},
+	LAYERZEROOFT: {
+		Name:     "LayerZero Omnichain Fungible Token (OFT)",
+		Url:      "https://docs.layerzero.network/v2/developers/evm/oft/quickstart",
+		Type:     LAYERZEROOFT,
+		Category: shared.CategoryBridging,
+		Tags:     []string{"cross-chain", "fungible", "layerzero"},
+		Authors:  []string{"LayerZero Labs"},
+		ABI:      `[{"inputs":[],"name":"oftVersion","outputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"},{"internalType":"uint64","name":"version","type":"uint64"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"token","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"approvalRequired","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"sharedDecimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"uint32","name":"dstEid","type":"uint32"},{"internalType":"bytes32","name":"to","type":"bytes32"},{"internalType":"uint256","name":"amountLD","type":"uint256"},{"internalType":"uint256","name":"minAmountLD","type":"uint256"},{"internalType":"bytes","name":"extraOptions","type":"bytes"},{"internalType":"bytes","name":"composeMsg","type":"bytes"},{"internalType":"bytes","name":"oftCmd","type":"bytes"}],"internalType":"struct SendParam","name":"sendParam","type":"tuple"}],"name":"quoteOFT","outputs":[{"components":[{"internalType":"uint256","name":"minAmountLD","type":"uint256"},{"internalType":"uint256","name":"maxAmountLD","type":"uint256"}],"internalType":"struct OFTLimit","name":"","type":"tuple"},{"components":[{"internalType":"int256","name":"feeAmountLD","type":"int256"},{"internalType":"string","name":"description","type":"string"}],"internalType":"struct OFTFeeDetail[]","name":"oftFeeDetails","type":"tuple[]"},{"components":[{"internalType":"uint256","name":"amountSentLD","type":"uint256"},{"internalType":"uint256","name":"amountReceivedLD","type":"uint256"}],"internalType":"struct OFTReceipt","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"uint32","name":"dstEid","type":"uint32"},{"internalType":"bytes32","name":"to","type":"bytes32"},{"internalType":"uint256","name":"amountLD","type":"uint256"},{"internalType":"uint256","name":"minAmountLD","type":"uint256"},{"internalType":"bytes","name":"extraOptions","type":"bytes"},{"internalType":"bytes","name":"composeMsg","type":"bytes"},{"internalType":"bytes","name":"oftCmd","type":"bytes"}],"internalType":"struct SendParam","name":"sendParam","type":"tuple"},{"internalType":"bool","name":"payInLzToken","type":"bool"}],"name":"quoteSend","outputs":[{"components":[{"internalType":"uint256","name":"nativeFee","type":"uint256"},{"internalType":"uint256","name":"lzTokenFee","type":"uint256"}],"internalType":"struct MessagingFee","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"components":[{"internalType":"uint32","name":"dstEid","type":"uint32"},{"internalType":"bytes32","name":"to","type":"bytes32"},{"internalType":"uint256","name":"amountLD","type":"uint256"},{"internalType":"uint256","name":"minAmountLD","type":"uint256"},{"internalType":"bytes","name":"extraOptions","type":"bytes"},{"internalType":"bytes","name":"composeMsg","type":"bytes"},{"internalType":"bytes","name":"oftCmd","type":"bytes"}],"internalType":"struct SendParam","name":"sendParam","type":"tuple"},{"components":[{"internalType":"uint256","name":"nativeFee","type":"uint256"},{"internalType":"uint256","name":"lzTokenFee","type":"uint256"}],"internalType":"struct MessagingFee","name":"fee","type":"tuple"},{"internalType":"address","name":"refundAddress","type":"address"}],"name":"send","outputs":[{"components":[{"internalType":"bytes32","name":"guid","type":"bytes32"},{"internalType":"uint64","name":"nonce","type":"uint64"},{"components":[{"internalType":"uint256","name":"nativeFee","type":"uint256"},{"internalType":"uint256","name":"lzTokenFee","type":"uint256"}],"internalType":"struct MessagingFee","name":"fee","type":"tuple"}],"internalType":"struct MessagingReceipt","name":"","type":"tuple"},{"components":[{"internalType":"uint256","name":"amountSentLD","type":"uint256"},{"internalType":"uint256","name":"amountReceivedLD","type":"uint256"}],"internalType":"struct OFTReceipt","name":"","type":"tuple"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"uint32","name":"srcEid","type":"uint32"},{"internalType":"bytes32","name":"sender","type":"bytes32"},{"internalType":"uint64","name":"nonce","type":"uint64"}],"internalType":"struct Origin","name":"origin","type":"tuple"},{"internalType":"bytes32","name":"guid","type":"bytes32"},{"internalType":"bytes","name":"message","type":"bytes"},{"internalType":"address","name":"executor","type":"address"},{"internalType":"bytes","name":"extraData","type":"bytes"}],"name":"lzReceive","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"endpoint","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint32","name":"eid","type":"uint32"}],"name":"peers","outputs":[{"internalType":"bytes32","name":"peer","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint32","name":"eid","type":"uint32"},{"internalType":"bytes32","name":"peer","type":"bytes32"}],"name":"setPeer","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"guid","type":"bytes32"},{"indexed":false,"internalType":"uint32","name":"dstEid","type":"uint32"},{"indexed":true,"internalType":"address","name":"fromAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"amountSentLD","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amountReceivedLD","type":"uint256"}],"name":"OFTSent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"guid","type":"bytes32"},{"indexed":false,"internalType":"uint32","name":"srcEid","type":"uint32"},{"indexed":true,"internalType":"address","name":"toAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"amountReceivedLD","type":"uint256"}],"name":"OFTReceived","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint32","name":"eid","type":"uint32"},{"indexed":false,"internalType":"bytes32","name":"peer","type":"bytes32"}],"name":"PeerSet","type":"event"},{"inputs":[],"name":"InvalidLocalDecimals","type":"error"},{"inputs":[{"internalType":"uint256","name":"amountLD","type":"uint256"},{"internalType":"uint256","name":"minAmountLD","type":"uint256"}],"name":"SlippageExceeded","type":"error"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("oftVersion", nil, []shared.Output{{Type: shared.TypeBytes4}, {Type: shared.TypeUint64}}),
+			shared.NewFunction("token", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("approvalRequired", nil, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("sharedDecimals", nil, []shared.Output{{Type: shared.TypeUint8}}),
+			shared.NewFunction("quoteOFT", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "dstEid", Type: shared.TypeUint32}, {Name: "to", Type: shared.TypeBytes32}, {Name: "amountLD", Type: shared.TypeUint256}, {Name: "minAmountLD", Type: shared.TypeUint256}, {Name: "extraOptions", Type: shared.TypeBytes}, {Name: "composeMsg", Type: shared.TypeBytes}, {Name: "oftCmd", Type: shared.TypeBytes}}}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "minAmountLD", Type: shared.TypeUint256}, {Name: "maxAmountLD", Type: shared.TypeUint256}}}, {Type: shared.TypeTupleArray, Components: []shared.Input{{Name: "feeAmountLD", Type: shared.TypeInt256}, {Name: "description", Type: shared.TypeString}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "amountSentLD", Type: shared.TypeUint256}, {Name: "amountReceivedLD", Type: shared.TypeUint256}}}}),
+			shared.NewFunction("quoteSend", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "dstEid", Type: shared.TypeUint32}, {Name: "to", Type: shared.TypeBytes32}, {Name: "amountLD", Type: shared.TypeUint256}, {Name: "minAmountLD", Type: shared.TypeUint256}, {Name: "extraOptions", Type: shared.TypeBytes}, {Name: "composeMsg", Type: shared.TypeBytes}, {Name: "oftCmd", Type: shared.TypeBytes}}}, {Type: shared.TypeBool}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "nativeFee", Type: shared.TypeUint256}, {Name: "lzTokenFee", Type: shared.TypeUint256}}}}),
+			shared.NewFunction("send", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "dstEid", Type: shared.TypeUint32}, {Name: "to", Type: shared.TypeBytes32}, {Name: "amountLD", Type: shared.TypeUint256}, {Name: "minAmountLD", Type: shared.TypeUint256}, {Name: "extraOptions", Type: shared.TypeBytes}, {Name: "composeMsg", Type: shared.TypeBytes}, {Name: "oftCmd", Type: shared.TypeBytes}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "nativeFee", Type: shared.TypeUint256}, {Name: "lzTokenFee", Type: shared.TypeUint256}}}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "guid", Type: shared.TypeBytes32}, {Name: "nonce", Type: shared.TypeUint64}, {Name: "fee", Type: shared.TypeTuple, Components: []shared.Input{{Name: "nativeFee", Type: shared.TypeUint256}, {Name: "lzTokenFee", Type: shared.TypeUint256}}}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "amountSentLD", Type: shared.TypeUint256}, {Name: "amountReceivedLD", Type: shared.TypeUint256}}}}),
+			shared.NewFunction("lzReceive", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "srcEid", Type: shared.TypeUint32}, {Name: "sender", Type: shared.TypeBytes32}, {Name: "nonce", Type: shared.TypeUint64}}}, {Type: shared.TypeBytes32}, {Type: shared.TypeBytes}, {Type: shared.TypeAddress}, {Type: shared.TypeBytes}}, nil),
+			shared.NewFunction("endpoint", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("peers", []shared.Input{{Type: shared.TypeUint32}}, []shared.Output{{Type: shared.TypeBytes32}}),
+			shared.NewFunction("setPeer", []shared.Input{{Type: shared.TypeUint32}, {Type: shared.TypeBytes32}}, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("OFTSent", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeUint32}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("OFTReceived", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}, {Type: shared.TypeUint32}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("PeerSet", []shared.Input{{Type: shared.TypeUint32}, {Type: shared.TypeBytes32}}, nil),
+		},
+		Errors: []shared.Error{
+			shared.NewError("InvalidLocalDecimals", nil),
+			shared.NewError("SlippageExceeded", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+		},
+	},
+	WORMHOLENTT: {
+		Name:     "Wormhole Native Token Transfers Manager (NTT)",
+		Url:      "https://wormhole.com/docs/build/contract-integrations/native-token-transfers/",
+		Type:     WORMHOLENTT,
+		Category: shared.CategoryBridging,
+		Tags:     []string{"cross-chain", "fungible", "wormhole"},
+		Authors:  []string{"Wormhole Foundation"},
+		ABI:      `[{"inputs":[{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint16","name":"recipientChain","type":"uint16"},{"internalType":"bytes32","name":"recipient","type":"bytes32"}],"name":"transfer","outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint64","name":"queueSequence","type":"uint64"}],"name":"completeOutboundQueuedTransfer","outputs":[{"internalType":"uint64","name":"","type":"uint64"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint64","name":"queueSequence","type":"uint64"}],"name":"cancelOutboundQueuedTransfer","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes32","name":"digest","type":"bytes32"}],"name":"completeInboundQueuedTransfer","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint16","name":"recipientChain","type":"uint16"},{"internalType":"bytes","name":"transceiverInstructions","type":"bytes"}],"name":"quoteDeliveryPrice","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint16","name":"sourceChainId","type":"uint16"},{"internalType":"bytes32","name":"sourceNttManagerAddress","type":"bytes32"},{"components":[{"internalType":"bytes32","name":"id","type":"bytes32"},{"internalType":"bytes32","name":"sender","type":"bytes32"},{"internalType":"bytes","name":"payload","type":"bytes"}],"internalType":"struct NttManagerMessage","name":"payload","type":"tuple"}],"name":"attestationReceived","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint16","name":"sourceChainId","type":"uint16"},{"internalType":"bytes32","name":"sourceNttManagerAddress","type":"bytes32"},{"components":[{"internalType":"bytes32","name":"id","type":"bytes32"},{"internalType":"bytes32","name":"sender","type":"bytes32"},{"internalType":"bytes","name":"payload","type":"bytes"}],"internalType":"struct NttManagerMessage","name":"message","type":"tuple"}],"name":"executeMsg","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"token","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"mode","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"chainId","outputs":[{"internalType":"uint16","name":"","type":"uint16"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"tokenDecimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint16","name":"chainId_","type":"uint16"}],"name":"getPeer","outputs":[{"components":[{"internalType":"bytes32","name":"peerAddress","type":"bytes32"},{"internalType":"uint8","name":"tokenDecimals","type":"uint8"}],"internalType":"struct NttManagerPeer","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint16","name":"peerChainId","type":"uint16"},{"internalType":"bytes32","name":"peerContract","type":"bytes32"},{"internalType":"uint8","name":"decimals","type":"uint8"},{"internalType":"uint256","name":"inboundLimit","type":"uint256"}],"name":"setPeer","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"limit","type":"uint256"}],"name":"setOutboundLimit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"limit","type":"uint256"},{"internalType":"uint16","name":"chainId","type":"uint16"}],"name":"setInboundLimit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"getCurrentOutboundCapacity","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint16","name":"chainId","type":"uint16"}],"name":"getCurrentInboundCapacity","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes32","name":"digest","type":"bytes32"}],"name":"isMessageApproved","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes32","name":"digest","type":"bytes32"}],"name":"isMessageExecuted","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"NTT_MANAGER_VERSION","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"recipient","type":"bytes32"},{"indexed":false,"internalType":"bytes32","name":"refundAddress","type":"bytes32"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"fee","type":"uint256"},{"indexed":false,"internalType":"uint16","name":"recipientChain","type":"uint16"},{"indexed":false,"internalType":"uint64","name":"msgSequence","type":"uint64"}],"name":"TransferSent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"digest","type":"bytes32"}],"name":"TransferRedeemed","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint16","name":"chainId_","type":"uint16"},{"indexed":false,"internalType":"bytes32","name":"oldPeerContract","type":"bytes32"},{"indexed":false,"internalType":"uint8","name":"oldPeerDecimals","type":"uint8"},{"indexed":false,"internalType":"bytes32","name":"peerContract","type":"bytes32"},{"indexed":false,"internalType":"uint8","name":"peerDecimals","type":"uint8"}],"name":"PeerUpdated","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"queueSequence","type":"uint64"}],"name":"OutboundTransferQueued","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"digest","type":"bytes32"}],"name":"InboundTransferQueued","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"digest","type":"bytes32"},{"indexed":false,"internalType":"address","name":"transceiver","type":"address"},{"indexed":false,"internalType":"uint8","name":"index","type":"uint8"}],"name":"MessageAttestedTo","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("transfer", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint16}, {Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeUint64}}),
+			shared.NewFunction("completeOutboundQueuedTransfer", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeUint64}}),
+			shared.NewFunction("cancelOutboundQueuedTransfer", []shared.Input{{Type: shared.TypeUint64}}, nil),
+			shared.NewFunction("completeInboundQueuedTransfer", []shared.Input{{Type: shared.TypeBytes32}}, nil),
+			shared.NewFunction("quoteDeliveryPrice", []shared.Input{{Type: shared.TypeUint16}, {Type: shared.TypeBytes}}, []shared.Output{{Type: shared.TypeUint256Array}, {Type: shared.TypeUint256}}),
+			shared.NewFunction("attestationReceived", []shared.Input{{Type: shared.TypeUint16}, {Type: shared.TypeBytes32}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "id", Type: shared.TypeBytes32}, {Name: "sender", Type: shared.TypeBytes32}, {Name: "payload", Type: shared.TypeBytes}}}}, nil),
+			shared.NewFunction("executeMsg", []shared.Input{{Type: shared.TypeUint16}, {Type: shared.TypeBytes32}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "id", Type: shared.TypeBytes32}, {Name: "sender", Type: shared.TypeBytes32}, {Name: "payload", Type: shared.TypeBytes}}}}, nil),
+			shared.NewFunction("token", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("mode", nil, []shared.Output{{Type: shared.TypeUint8}}),
+			shared.NewFunction("chainId", nil, []shared.Output{{Type: shared.TypeUint16}}),
+			shared.NewFunction("tokenDecimals", nil, []shared.Output{{Type: shared.TypeUint8}}),
+			shared.NewFunction("getPeer", []shared.Input{{Type: shared.TypeUint16}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "peerAddress", Type: shared.TypeBytes32}, {Name: "tokenDecimals", Type: shared.TypeUint8}}}}),
+			shared.NewFunction("setPeer", []shared.Input{{Type: shared.TypeUint16}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint8}, {Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("setOutboundLimit", []shared.Input{{Type: shared.TypeUint256}}, nil),
+			shared.NewFunction("setInboundLimit", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint16}}, nil),
+			shared.NewFunction("getCurrentOutboundCapacity", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("getCurrentInboundCapacity", []shared.Input{{Type: shared.TypeUint16}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("isMessageApproved", []shared.Input{{Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("isMessageExecuted", []shared.Input{{Type: shared.TypeBytes32}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("NTT_MANAGER_VERSION", nil, []shared.Output{{Type: shared.TypeString}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("TransferSent", []shared.Input{{Type: shared.TypeBytes32}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint16}, {Type: shared.TypeUint64}}, nil),
+			shared.NewEvent("TransferRedeemed", []shared.Input{{Type: shared.TypeBytes32, Indexed: true}}, nil),
+			shared.NewEvent("PeerUpdated", []shared.Input{{Type: shared.TypeUint16, Indexed: true}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint8}, {Type: shared.TypeBytes32}, {Type: shared.TypeUint8}}, nil),
+			shared.NewEvent("OutboundTransferQueued", []shared.Input{{Type: shared.TypeUint64}}, nil),
+			shared.NewEvent("InboundTransferQueued", []shared.Input{{Type: shared.TypeBytes32}}, nil),
+			shared.NewEvent("MessageAttestedTo", []shared.Input{{Type: shared.TypeBytes32}, {Type: shared.TypeAddress}, {Type: shared.TypeUint8}}, nil),
+		},
+	},
+	CCIPTOKENPOOL: {
+		Name:     "Chainlink CCIP Token Pool",
+		Url:      "https://docs.chain.link/ccip/architecture#token-pools",
+		Type:     CCIPTOKENPOOL,
+		Category: shared.CategoryBridging,
+		Tags:     []string{"cross-chain", "fungible", "chainlink"},
+		Authors:  []string{"Chainlink Labs"},
+		ABI:      `[{"inputs":[{"components":[{"internalType":"bytes","name":"receiver","type":"bytes"},{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"},{"internalType":"address","name":"originalSender","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"localToken","type":"address"}],"internalType":"struct LockOrBurnInV1","name":"lockOrBurnIn","type":"tuple"}],"name":"lockOrBurn","outputs":[{"components":[{"internalType":"bytes","name":"destTokenAddress","type":"bytes"},{"internalType":"bytes","name":"destPoolData","type":"bytes"}],"internalType":"struct LockOrBurnOutV1","name":"","type":"tuple"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"internalType":"bytes","name":"originalSender","type":"bytes"},{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"},{"internalType":"address","name":"receiver","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"address","name":"localToken","type":"address"},{"internalType":"bytes","name":"sourcePoolAddress","type":"bytes"},{"internalType":"bytes","name":"sourcePoolData","type":"bytes"},{"internalType":"bytes","name":"offchainTokenData","type":"bytes"}],"internalType":"struct ReleaseOrMintInV1","name":"releaseOrMintIn","type":"tuple"}],"name":"releaseOrMint","outputs":[{"components":[{"internalType":"uint256","name":"destinationAmount","type":"uint256"}],"internalType":"struct ReleaseOrMintOutV1","name":"","type":"tuple"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"isSupportedChain","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"}],"name":"isSupportedToken","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getToken","outputs":[{"internalType":"address","name":"token","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getRmnProxy","outputs":[{"internalType":"address","name":"rmnProxy","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getRouter","outputs":[{"internalType":"address","name":"router","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getSupportedChains","outputs":[{"internalType":"uint64[]","name":"","type":"uint64[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"getRemotePool","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"getRemoteToken","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"getCurrentOutboundRateLimiterState","outputs":[{"components":[{"internalType":"uint128","name":"tokens","type":"uint128"},{"internalType":"uint32","name":"lastUpdated","type":"uint32"},{"internalType":"bool","name":"isEnabled","type":"bool"},{"internalType":"uint128","name":"capacity","type":"uint128"},{"internalType":"uint128","name":"rate","type":"uint128"}],"internalType":"struct TokenBucket","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"getCurrentInboundRateLimiterState","outputs":[{"components":[{"internalType":"uint128","name":"tokens","type":"uint128"},{"internalType":"uint32","name":"lastUpdated","type":"uint32"},{"internalType":"bool","name":"isEnabled","type":"bool"},{"internalType":"uint128","name":"capacity","type":"uint128"},{"internalType":"uint128","name":"rate","type":"uint128"}],"internalType":"struct TokenBucket","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Locked","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Burned","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Released","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":true,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"Minted","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"remoteChainSelector","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"remoteToken","type":"bytes"},{"indexed":false,"components":[{"internalType":"bool","name":"isEnabled","type":"bool"},{"internalType":"uint128","name":"capacity","type":"uint128"},{"internalType":"uint128","name":"rate","type":"uint128"}],"internalType":"struct Config","name":"outboundRateLimiterConfig","type":"tuple"},{"indexed":false,"components":[{"internalType":"bool","name":"isEnabled","type":"bool"},{"internalType":"uint128","name":"capacity","type":"uint128"},{"internalType":"uint128","name":"rate","type":"uint128"}],"internalType":"struct Config","name":"inboundRateLimiterConfig","type":"tuple"}],"name":"ChainAdded","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"remoteChainSelector","type":"uint64"}],"name":"ChainRemoved","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint64","name":"remoteChainSelector","type":"uint64"},{"indexed":false,"internalType":"bytes","name":"previousPoolAddress","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"remotePoolAddress","type":"bytes"}],"name":"RemotePoolSet","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("lockOrBurn", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "receiver", Type: shared.TypeBytes}, {Name: "remoteChainSelector", Type: shared.TypeUint64}, {Name: "originalSender", Type: shared.TypeAddress}, {Name: "amount", Type: shared.TypeUint256}, {Name: "localToken", Type: shared.TypeAddress}}}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "destTokenAddress", Type: shared.TypeBytes}, {Name: "destPoolData", Type: shared.TypeBytes}}}}),
+			shared.NewFunction("releaseOrMint", []shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "originalSender", Type: shared.TypeBytes}, {Name: "remoteChainSelector", Type: shared.TypeUint64}, {Name: "receiver", Type: shared.TypeAddress}, {Name: "amount", Type: shared.TypeUint256}, {Name: "localToken", Type: shared.TypeAddress}, {Name: "sourcePoolAddress", Type: shared.TypeBytes}, {Name: "sourcePoolData", Type: shared.TypeBytes}, {Name: "offchainTokenData", Type: shared.TypeBytes}}}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "destinationAmount", Type: shared.TypeUint256}}}}),
+			shared.NewFunction("isSupportedChain", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("isSupportedToken", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("getToken", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("getRmnProxy", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("getRouter", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("getSupportedChains", nil, []shared.Output{{Type: shared.TypeUint64Array}}),
+			shared.NewFunction("getRemotePool", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeBytes}}),
+			shared.NewFunction("getRemoteToken", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeBytes}}),
+			shared.NewFunction("getCurrentOutboundRateLimiterState", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokens", Type: shared.TypeUint128}, {Name: "lastUpdated", Type: shared.TypeUint32}, {Name: "isEnabled", Type: shared.TypeBool}, {Name: "capacity", Type: shared.TypeUint128}, {Name: "rate", Type: shared.TypeUint128}}}}),
+			shared.NewFunction("getCurrentInboundRateLimiterState", []shared.Input{{Type: shared.TypeUint64}}, []shared.Output{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "tokens", Type: shared.TypeUint128}, {Name: "lastUpdated", Type: shared.TypeUint32}, {Name: "isEnabled", Type: shared.TypeBool}, {Name: "capacity", Type: shared.TypeUint128}, {Name: "rate", Type: shared.TypeUint128}}}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Locked", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Burned", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Released", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Minted", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("ChainAdded", []shared.Input{{Type: shared.TypeUint64}, {Type: shared.TypeBytes}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "isEnabled", Type: shared.TypeBool}, {Name: "capacity", Type: shared.TypeUint128}, {Name: "rate", Type: shared.TypeUint128}}}, {Type: shared.TypeTuple, Components: []shared.Input{{Name: "isEnabled", Type: shared.TypeBool}, {Name: "capacity", Type: shared.TypeUint128}, {Name: "rate", Type: shared.TypeUint128}}}}, nil),
+			shared.NewEvent("ChainRemoved", []shared.Input{{Type: shared.TypeUint64}}, nil),
+			shared.NewEvent("RemotePoolSet", []shared.Input{{Type: shared.TypeUint64, Indexed: true}, {Type: shared.TypeBytes}, {Type: shared.TypeBytes}}, nil),
+		},
+	},
 }
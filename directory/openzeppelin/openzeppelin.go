@@ -0,0 +1,31 @@
+// Package openzeppelin holds standard definitions generated out of OpenZeppelin contracts sources, so that the
+// directory follows upstream interfaces instead of hand-typed ones. The committed definitions are generated out of
+// the OpenZeppelin v5 sources vendored as ozgen test fixtures, which only cover the standards listed within the
+// go:generate directive below, and are regenerated with:
+//
+//	go generate ./directory/openzeppelin
+//
+// Only the ABI, functions, events and errors are generated, while names, URLs and the remaining metadata of the
+// standards are maintained in the directory. The remaining ozgen targets require the full sources pinned by the
+// libs/openzeppelin submodule:
+//
+//	git submodule update --init libs/openzeppelin
+//	go run ./internal/ozgen -src libs/openzeppelin -o directory/openzeppelin/standards_gen.go
+package openzeppelin
+
+//go:generate go run ../../internal/ozgen -src ../../internal/ozgen/testdata -o standards_gen.go -standards ERC20,ERC2981,ERC20ERRORS,ERC1155ERRORS,OZOWNABLE
+
+import "github.com/unpackdev/standards/shared"
+
+// standards holds generated definitions keyed by the standard they define.
+var standards = make(map[shared.Standard]shared.ContractStandard)
+
+// register registers the generated definition of the standard.
+func register(s shared.Standard, cs shared.ContractStandard) {
+	standards[s] = cs
+}
+
+// Standards returns the generated definitions keyed by the standard they define.
+func Standards() map[shared.Standard]shared.ContractStandard {
+	return standards
+}
@@ -0,0 +1,75 @@
+// Code generated by ozgen from the OpenZeppelin contracts sources in ../../internal/ozgen/testdata. DO NOT EDIT.
+
+package openzeppelin
+
+import "github.com/unpackdev/standards/shared"
+
+func init() {
+	// IERC20 out of contracts/token/ERC20/IERC20.sol.
+	register("ERC20", shared.ContractStandard{
+		ABI: `[{"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"name":"allowance","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("totalSupply", nil, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("transfer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("allowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+			shared.NewFunction("approve", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("transferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			shared.NewEvent("Approval", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+		},
+	})
+	// IERC2981 out of contracts/interfaces/IERC2981.sol.
+	register("ERC2981", shared.ContractStandard{
+		ABI: `[{"inputs":[{"name":"tokenId","type":"uint256"},{"name":"salePrice","type":"uint256"}],"name":"royaltyInfo","outputs":[{"name":"receiver","type":"address"},{"name":"royaltyAmount","type":"uint256"}],"stateMutability":"view","type":"function"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("royaltyInfo", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}),
+		},
+	})
+	// IERC20Errors out of contracts/interfaces/draft-IERC6093.sol.
+	register("ERC20ERRORS", shared.ContractStandard{
+		ABI: `[{"inputs":[{"name":"sender","type":"address"},{"name":"balance","type":"uint256"},{"name":"needed","type":"uint256"}],"name":"ERC20InsufficientBalance","type":"error"},{"inputs":[{"name":"sender","type":"address"}],"name":"ERC20InvalidSender","type":"error"},{"inputs":[{"name":"receiver","type":"address"}],"name":"ERC20InvalidReceiver","type":"error"},{"inputs":[{"name":"spender","type":"address"},{"name":"allowance","type":"uint256"},{"name":"needed","type":"uint256"}],"name":"ERC20InsufficientAllowance","type":"error"},{"inputs":[{"name":"approver","type":"address"}],"name":"ERC20InvalidApprover","type":"error"},{"inputs":[{"name":"spender","type":"address"}],"name":"ERC20InvalidSpender","type":"error"}]`,
+		Errors: []shared.Error{
+			shared.NewError("ERC20InsufficientBalance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewError("ERC20InvalidSender", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC20InvalidReceiver", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC20InsufficientAllowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewError("ERC20InvalidApprover", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC20InvalidSpender", []shared.Input{{Type: shared.TypeAddress}}),
+		},
+	})
+	// IERC1155Errors out of contracts/interfaces/draft-IERC6093.sol.
+	register("ERC1155ERRORS", shared.ContractStandard{
+		ABI: `[{"inputs":[{"name":"sender","type":"address"},{"name":"balance","type":"uint256"},{"name":"needed","type":"uint256"},{"name":"tokenId","type":"uint256"}],"name":"ERC1155InsufficientBalance","type":"error"},{"inputs":[{"name":"sender","type":"address"}],"name":"ERC1155InvalidSender","type":"error"},{"inputs":[{"name":"receiver","type":"address"}],"name":"ERC1155InvalidReceiver","type":"error"},{"inputs":[{"name":"operator","type":"address"},{"name":"owner","type":"address"}],"name":"ERC1155MissingApprovalForAll","type":"error"},{"inputs":[{"name":"approver","type":"address"}],"name":"ERC1155InvalidApprover","type":"error"},{"inputs":[{"name":"operator","type":"address"}],"name":"ERC1155InvalidOperator","type":"error"},{"inputs":[{"name":"idsLength","type":"uint256"},{"name":"valuesLength","type":"uint256"}],"name":"ERC1155InvalidArrayLength","type":"error"}]`,
+		Errors: []shared.Error{
+			shared.NewError("ERC1155InsufficientBalance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+			shared.NewError("ERC1155InvalidSender", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC1155InvalidReceiver", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC1155MissingApprovalForAll", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}}),
+			shared.NewError("ERC1155InvalidApprover", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC1155InvalidOperator", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("ERC1155InvalidArrayLength", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}),
+		},
+	})
+	// Ownable2Step out of contracts/access/Ownable2Step.sol.
+	register("OZOWNABLE", shared.ContractStandard{
+		ABI: `[{"inputs":[],"name":"pendingOwner","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"newOwner","type":"address"}],"name":"transferOwnership","stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"acceptOwnership","stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"renounceOwnership","stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"previousOwner","type":"address"},{"indexed":true,"name":"newOwner","type":"address"}],"name":"OwnershipTransferStarted","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"previousOwner","type":"address"},{"indexed":true,"name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"},{"inputs":[{"name":"account","type":"address"}],"name":"OwnableUnauthorizedAccount","type":"error"},{"inputs":[{"name":"owner","type":"address"}],"name":"OwnableInvalidOwner","type":"error"}]`,
+		Functions: []shared.Function{
+			shared.NewFunction("pendingOwner", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("transferOwnership", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			shared.NewFunction("acceptOwnership", nil, nil),
+			shared.NewFunction("owner", nil, []shared.Output{{Type: shared.TypeAddress}}),
+			shared.NewFunction("renounceOwnership", nil, nil),
+		},
+		Events: []shared.Event{
+			shared.NewEvent("OwnershipTransferStarted", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+			shared.NewEvent("OwnershipTransferred", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),
+		},
+		Errors: []shared.Error{
+			shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}}),
+			shared.NewError("OwnableInvalidOwner", []shared.Input{{Type: shared.TypeAddress}}),
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package standards
+
+import (
+	"github.com/unpackdev/standards/shared"
+)
+
+// marker represents a contract member distinguishing a standard from the standards it is mutually exclusive with.
+type marker struct {
+	Kind      string // Kind of the member, either "function" or "event".
+	Signature string // Canonical signature of the member, e.g. "ownerOf(uint256)".
+	Indexed   int    // Number of indexed event parameters, telling apart events sharing a signature.
+}
+
+// exclusion represents standards a single contract does not implement at the same time, although they share
+// members, together with the markers of each standard.
+type exclusion struct {
+	Markers map[shared.Standard][]marker
+}
+
+// exclusions holds the mutually exclusive standards resolved by Disambiguate. ERC-20 and ERC-721 share Transfer,
+// Approval, balanceOf and transferFrom, so a token of either standard scores substantially for the other one.
+var exclusions = []exclusion{
+	{
+		Markers: map[shared.Standard][]marker{
+			ERC20: {
+				{Kind: "function", Signature: "decimals()"},
+				{Kind: "function", Signature: "allowance(address,address)"},
+				{Kind: "event", Signature: "Transfer(address,address,uint256)", Indexed: 2},
+				{Kind: "event", Signature: "Approval(address,address,uint256)", Indexed: 2},
+			},
+			ERC721: {
+				{Kind: "function", Signature: "ownerOf(uint256)"},
+				{Kind: "function", Signature: "getApproved(uint256)"},
+				{Kind: "event", Signature: "Transfer(address,address,uint256)", Indexed: 3},
+				{Kind: "event", Signature: "Approval(address,address,uint256)", Indexed: 3},
+			},
+		},
+	},
+}
+
+// Disambiguate resolves discoveries of mutually exclusive standards, e.g. ERC-20 and ERC-721, down to the single
+// standard the contract implements and drops discoveries of the others, including standards extending them.
+// The standard whose distinguishing members (such as decimals or ownerOf and the indexed token id of Transfer) are
+// found the most in the contract wins. Discoveries are left intact when the contract holds as many distinguishing
+// members of each standard, as it can not be told which one it implements.
+//
+// Parameters:
+// - contract: The contract the discoveries were made for.
+// - discoveries: Discoveries of the contract, as returned by Detector.Detect.
+//
+// Returns:
+// - []Discovery: The discoveries without the excluded standards, in their original order.
+func Disambiguate(contract *shared.ContractMatcher, discoveries []shared.Discovery) []shared.Discovery {
+	if contract == nil {
+		return discoveries
+	}
+
+	excluded := make(map[shared.Standard]bool)
+	for _, rule := range exclusions {
+		discovered := make([]shared.Standard, 0, len(rule.Markers))
+		for _, discovery := range discoveries {
+			if _, ok := rule.Markers[discovery.Standard]; ok && discovery.Confidence != shared.NoConfidence {
+				discovered = append(discovered, discovery.Standard)
+			}
+		}
+		if len(discovered) < 2 {
+			continue
+		}
+
+		var winner shared.Standard
+		best, tied := -1, false
+		for _, s := range discovered {
+			count := 0
+			for _, m := range rule.Markers[s] {
+				if m.matches(contract) {
+					count++
+				}
+			}
+
+			switch {
+			case count > best:
+				winner, best, tied = s, count, false
+			case count == best:
+				tied = true
+			}
+		}
+		if tied {
+			continue
+		}
+
+		for _, s := range discovered {
+			if s != winner {
+				excluded[s] = true
+			}
+		}
+	}
+
+	if len(excluded) == 0 {
+		return discoveries
+	}
+
+	toReturn := make([]shared.Discovery, 0, len(discoveries))
+	for _, discovery := range discoveries {
+		if !isExcluded(discovery.Standard, excluded) {
+			toReturn = append(toReturn, discovery)
+		}
+	}
+	return toReturn
+}
+
+// isExcluded reports whether the standard or all of the standards it extends are excluded, so that an ERC-20
+// token is not reported as an ERC721A, while ERC-2981 royalties remain applicable to ERC-1155 tokens.
+func isExcluded(s shared.Standard, excluded map[shared.Standard]bool) bool {
+	if excluded[s] {
+		return true
+	}
+
+	eip, err := GetContractByStandard(s)
+	if err != nil || len(eip.GetStandard().Extends) == 0 {
+		return false
+	}
+	for _, extended := range eip.GetStandard().Extends {
+		if !excluded[extended] {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether the contract declares the marker member.
+func (m marker) matches(contract *shared.ContractMatcher) bool {
+	switch m.Kind {
+	case "function":
+		for _, fn := range contract.Functions {
+			if fn.Signature() == m.Signature {
+				return true
+			}
+		}
+	case "event":
+		for _, event := range contract.Events {
+			if event.Signature() != m.Signature {
+				continue
+			}
+
+			indexed := 0
+			for _, input := range event.Inputs {
+				if input.Indexed {
+					indexed++
+				}
+			}
+			if indexed == m.Indexed {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package standards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestDetectorDisambiguate(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	ambiguous, err := NewDetector(DetectorOptions{KeepAmbiguous: true})
+	assert.NoError(t, err)
+
+	detected := func(t *testing.T, detector *Detector, contract *shared.ContractMatcher) map[shared.Standard]shared.ConfidenceLevel {
+		discoveries, err := detector.Detect(contract)
+		assert.NoError(t, err)
+
+		toReturn := make(map[shared.Standard]shared.ConfidenceLevel, len(discoveries))
+		for _, discovery := range discoveries {
+			toReturn[discovery.Standard] = discovery.Confidence
+		}
+		return toReturn
+	}
+
+	transfer := func(indexed bool) shared.Event {
+		return shared.NewEvent("Transfer", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256, Indexed: indexed}}, nil)
+	}
+	balanceOf := shared.NewFunction("balanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}})
+
+	testCases := []struct {
+		name     string
+		contract *shared.ContractMatcher
+		expected shared.Standard
+		excluded []shared.Standard
+	}{
+		{
+			name:     "ERC20",
+			contract: standardMatcher(t, ERC20),
+			expected: ERC20,
+			excluded: []shared.Standard{ERC721, ERC721A},
+		},
+		{
+			name:     "ERC721",
+			contract: standardMatcher(t, ERC721),
+			expected: ERC721,
+			excluded: []shared.Standard{ERC20},
+		},
+		{
+			name: "IndexedTokenId",
+			contract: &shared.ContractMatcher{
+				Functions: []shared.Function{balanceOf},
+				Events:    []shared.Event{transfer(true)},
+			},
+			expected: ERC721,
+			excluded: []shared.Standard{ERC20},
+		},
+		{
+			name: "Value",
+			contract: &shared.ContractMatcher{
+				Functions: []shared.Function{balanceOf},
+				Events:    []shared.Event{transfer(false)},
+			},
+			expected: ERC20,
+			excluded: []shared.Standard{ERC721},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			kept := detected(t, ambiguous, testCase.contract)
+			for _, s := range testCase.excluded {
+				assert.Contains(t, kept, s)
+			}
+
+			resolved := detected(t, detector, testCase.contract)
+			assert.Contains(t, resolved, testCase.expected)
+			for _, s := range testCase.excluded {
+				assert.NotContains(t, resolved, s)
+			}
+		})
+	}
+
+	t.Run("Undecided", func(t *testing.T) {
+		contract := &shared.ContractMatcher{Functions: []shared.Function{balanceOf}}
+
+		resolved := detected(t, detector, contract)
+		assert.Contains(t, resolved, ERC20)
+		assert.Contains(t, resolved, ERC721)
+	})
+}
+
+func TestDisambiguateExtensions(t *testing.T) {
+	loadStandards(t)
+
+	excluded := map[shared.Standard]bool{ERC721: true}
+	assert.True(t, isExcluded(ERC721, excluded))
+	assert.True(t, isExcluded(ERC721A, excluded))
+	assert.False(t, isExcluded(ERC2981, excluded)) // Also extends ERC1155.
+	assert.False(t, isExcluded(ERC20, excluded))
+	assert.Nil(t, Disambiguate(nil, nil))
+}
@@ -6,4 +6,19 @@ var (
 
 	// ErrStandardNotFound is returned when a standard is not found.
 	ErrStandardNotFound = errors.New("standard not found")
+
+	// ErrNoStandardsRegistered is returned when detection is attempted before any standard is registered.
+	ErrNoStandardsRegistered = errors.New("no standards registered")
+
+	// ErrInvalidContract is returned when a nil contract matcher is provided for detection.
+	ErrInvalidContract = errors.New("invalid contract matcher")
+
+	// ErrInvalidQuery is returned when a standards query holds a malformed selector, topic or signature.
+	ErrInvalidQuery = errors.New("invalid standards query")
+
+	// ErrInvalidLog is returned when an observed event log holds a malformed topic or topic count.
+	ErrInvalidLog = errors.New("invalid event log")
+
+	// ErrNoBehaviourSuite is returned when behavioural checks are requested for a standard without scenarios.
+	ErrNoBehaviourSuite = errors.New("no behavioural scenarios for standard")
 )
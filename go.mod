@@ -3,15 +3,24 @@ module github.com/unpackdev/standards
 go 1.22.0
 
 require (
+	github.com/antlr4-go/antlr/v4 v4.13.0
 	github.com/goccy/go-json v0.10.2
 	github.com/stretchr/testify v1.9.0
 	github.com/unpackdev/protos v0.3.5
 	github.com/unpackdev/solgo v0.3.4
+	golang.org/x/crypto v0.21.0
+	google.golang.org/grpc v1.62.1
+	google.golang.org/protobuf v1.33.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	google.golang.org/protobuf v1.33.0 // indirect
+	golang.org/x/exp v0.0.0-20240222234643-814bf88cf225 // indirect
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240311173647-c811ad7063a7 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
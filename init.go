@@ -1,8 +1,87 @@
 package standards
 
-import "github.com/unpackdev/standards/shared"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/unpackdev/standards/directory/openzeppelin"
+	"github.com/unpackdev/standards/shared"
+)
 
 func init() {
-	// Initialize the storage map so it can be accessed globally.
+	// Initialize the storage maps so they can be accessed globally.
+	reset()
+
+	// Replace members of hand-written standards with definitions generated out of OpenZeppelin sources, if any.
+	for name, generated := range openzeppelin.Standards() {
+		if standard, ok := standards[name]; ok {
+			merged, err := mergeGenerated(standard, generated)
+			if err != nil {
+				panic(err)
+			}
+			standards[name] = merged
+		}
+	}
+
+	// Resolve function state mutability out of the standard ABIs so it can be used while matching.
+	for name, standard := range standards {
+		if err := standard.ResolveStateMutability(); err != nil {
+			panic(err)
+		}
+		for i := range standard.Versions {
+			if err := standard.Versions[i].ResolveStateMutability(); err != nil {
+				panic(err)
+			}
+		}
+		standards[name] = standard
+	}
+}
+
+// reset clears the registry: the storage together with the inverted index and versions of registered standards.
+func reset() {
 	storage = make(map[shared.Standard]shared.EIP)
+	index = make(map[string][]shared.Standard)
+	versions = make(map[shared.Standard][]shared.EIP)
+}
+
+// mergeGenerated returns the standard with its ABI and members replaced by the generated definition, keeping the
+// hand-written metadata and versions. Generated definitions only replace members they cover: an error is returned
+// if any hand-written function, event or error is missing from the generated definition.
+func mergeGenerated(standard shared.ContractStandard, generated shared.ContractStandard) (shared.ContractStandard, error) {
+	signatures := make(map[string]bool)
+	for _, fn := range generated.Functions {
+		signatures["function "+fn.Signature()] = true
+	}
+	for _, event := range generated.Events {
+		signatures["event "+event.Signature()] = true
+	}
+	for _, e := range generated.Errors {
+		signatures["error "+e.Signature()] = true
+	}
+
+	var missing []string
+	for _, fn := range standard.Functions {
+		if !signatures["function "+fn.Signature()] {
+			missing = append(missing, "function "+fn.Signature())
+		}
+	}
+	for _, event := range standard.Events {
+		if !signatures["event "+event.Signature()] {
+			missing = append(missing, "event "+event.Signature())
+		}
+	}
+	for _, e := range standard.Errors {
+		if !signatures["error "+e.Signature()] {
+			missing = append(missing, "error "+e.Signature())
+		}
+	}
+	if len(missing) > 0 {
+		return standard, fmt.Errorf("generated definition of standard %s drops %s", standard.Type, strings.Join(missing, ", "))
+	}
+
+	standard.ABI = generated.ABI
+	standard.Functions = generated.Functions
+	standard.Events = generated.Events
+	standard.Errors = generated.Errors
+	return standard, nil
 }
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/unpackdev/standards/shared"
+	"github.com/unpackdev/standards/solidity"
+)
+
+// target maps a registered standard to the OpenZeppelin source file and contract its definition is generated from.
+type target struct {
+	Standard  shared.Standard // Standard the definition is generated for.
+	Path      string          // Source file, relative to the OpenZeppelin repository root.
+	Contract  string          // Contract or interface defined within the source file.
+	Inherited bool            // Whether members inherited from imported sources are included.
+}
+
+// targets lists the standards generated out of OpenZeppelin sources. Extension interfaces only include their own
+// members, as the directory defines the standards they extend separately. Only interfaces covering every member of
+// the directory definition are listed, as generated members replace the hand-written ones.
+var targets = []target{
+	// ERC-20 name, symbol and decimals are optional, so they are left out along with IERC20Metadata.
+	{Standard: "ERC20", Path: "contracts/token/ERC20/IERC20.sol", Contract: "IERC20"},
+	{Standard: "ERC1155", Path: "contracts/token/ERC1155/IERC1155.sol", Contract: "IERC1155"},
+	{Standard: "ERC1967", Path: "contracts/interfaces/IERC1967.sol", Contract: "IERC1967"},
+	{Standard: "ERC2309", Path: "contracts/interfaces/IERC2309.sol", Contract: "IERC2309"},
+	{Standard: "ERC2981", Path: "contracts/interfaces/IERC2981.sol", Contract: "IERC2981"},
+	{Standard: "ERC3156", Path: "contracts/interfaces/IERC3156FlashLender.sol", Contract: "IERC3156FlashLender"},
+	{Standard: "ERC3156BORROWER", Path: "contracts/interfaces/IERC3156FlashBorrower.sol", Contract: "IERC3156FlashBorrower"},
+	{Standard: "ERC4906", Path: "contracts/interfaces/IERC4906.sol", Contract: "IERC4906"},
+	{Standard: "ERC20ERRORS", Path: "contracts/interfaces/draft-IERC6093.sol", Contract: "IERC20Errors"},
+	{Standard: "ERC721ERRORS", Path: "contracts/interfaces/draft-IERC6093.sol", Contract: "IERC721Errors"},
+	{Standard: "ERC1155ERRORS", Path: "contracts/interfaces/draft-IERC6093.sol", Contract: "IERC1155Errors"},
+	{Standard: "OZOWNABLE", Path: "contracts/access/Ownable2Step.sol", Contract: "Ownable2Step", Inherited: true},
+}
+
+// selectTargets returns the targets of the provided standards, in order of the targets, or all targets if no
+// standards are provided.
+func selectTargets(targets []target, standards []string) ([]target, error) {
+	if len(standards) == 0 {
+		return targets, nil
+	}
+
+	selected := make(map[shared.Standard]bool, len(standards))
+	for _, s := range standards {
+		selected[shared.Standard(s)] = true
+	}
+
+	toReturn := make([]target, 0, len(standards))
+	for _, t := range targets {
+		if selected[t.Standard] {
+			toReturn = append(toReturn, t)
+			delete(selected, t.Standard)
+		}
+	}
+
+	for s := range selected {
+		return nil, fmt.Errorf("no target for standard %s", s)
+	}
+	return toReturn, nil
+}
+
+// importPattern matches Solidity import directives, capturing the imported path.
+var importPattern = regexp.MustCompile(`import\s+(?:[^"';]*\s+from\s+)?["']([^"']+)["']\s*;`)
+
+// typeConstants maps canonical ABI types to the shared package constants used within generated definitions.
+var typeConstants = map[string]string{
+	shared.TypeString:       "TypeString",
+	shared.TypeAddress:      "TypeAddress",
+	shared.TypeUint256:      "TypeUint256",
+	shared.TypeBool:         "TypeBool",
+	shared.TypeBytes:        "TypeBytes",
+	shared.TypeBytes32:      "TypeBytes32",
+	shared.TypeAddressArray: "TypeAddressArray",
+	shared.TypeUint256Array: "TypeUint256Array",
+	shared.TypeUint8:        "TypeUint8",
+	shared.TypeUint16:       "TypeUint16",
+	shared.TypeUint24:       "TypeUint24",
+	shared.TypeUint32:       "TypeUint32",
+	shared.TypeUint40:       "TypeUint40",
+	shared.TypeUint48:       "TypeUint48",
+	shared.TypeUint64:       "TypeUint64",
+	shared.TypeUint96:       "TypeUint96",
+	shared.TypeUint112:      "TypeUint112",
+	shared.TypeUint128:      "TypeUint128",
+	shared.TypeUint160:      "TypeUint160",
+	shared.TypeUint192:      "TypeUint192",
+	shared.TypeInt24:        "TypeInt24",
+	shared.TypeInt56:        "TypeInt56",
+	shared.TypeInt128:       "TypeInt128",
+	shared.TypeInt256:       "TypeInt256",
+	shared.TypeBytes4:       "TypeBytes4",
+	shared.TypeBytesArray:   "TypeBytesArray",
+	shared.TypeUint32Array:  "TypeUint32Array",
+	shared.TypeUint64Array:  "TypeUint64Array",
+	shared.TypeUint160Array: "TypeUint160Array",
+	shared.TypeInt56Array:   "TypeInt56Array",
+	shared.TypeTuple:        "TypeTuple",
+	shared.TypeTupleArray:   "TypeTupleArray",
+}
+
+// abiParameter and abiEntry represent the JSON ABI emitted for generated definitions.
+type abiParameter struct {
+	Indexed    *bool          `json:"indexed,omitempty"`
+	Components []abiParameter `json:"components,omitempty"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+}
+
+type abiEntry struct {
+	Anonymous       *bool          `json:"anonymous,omitempty"`
+	Inputs          []abiParameter `json:"inputs"`
+	Name            string         `json:"name"`
+	Outputs         []abiParameter `json:"outputs,omitempty"`
+	StateMutability string         `json:"stateMutability,omitempty"`
+	Type            string         `json:"type"`
+}
+
+// generate parses the target contracts out of the OpenZeppelin sources at the provided root and returns the
+// formatted Go source registering their definitions in the package of the provided name.
+func generate(root string, pkg string, targets []target) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by ozgen from the OpenZeppelin contracts sources in %s. DO NOT EDIT.\n\n", filepath.ToSlash(root))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/unpackdev/standards/shared\"\n\n")
+	fmt.Fprintf(&buf, "func init() {\n")
+
+	for _, t := range targets {
+		contract, err := parseTarget(root, t)
+		if err != nil {
+			return nil, fmt.Errorf("standard %s: %w", t.Standard, err)
+		}
+		if err := writeStandard(&buf, t, contract); err != nil {
+			return nil, fmt.Errorf("standard %s: %w", t.Standard, err)
+		}
+	}
+
+	fmt.Fprintf(&buf, "}\n")
+
+	toReturn, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failure to format generated source: %w", err)
+	}
+	return toReturn, nil
+}
+
+// parseTarget parses the target source file, together with the sources it imports when inherited members are
+// included, and returns the target contract.
+func parseTarget(root string, t target) (*solidity.Contract, error) {
+	path := filepath.Join(root, filepath.FromSlash(t.Path))
+
+	var src []byte
+	var err error
+	if t.Inherited {
+		src, err = flattenSource(root, path, map[string]bool{})
+	} else {
+		src, err = readSource(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	contracts, err := solidity.Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.Path, err)
+	}
+
+	contract := solidity.Find(contracts, t.Contract)
+	if contract == nil {
+		return nil, fmt.Errorf("contract %s not found in %s", t.Contract, t.Path)
+	}
+
+	// Types that are not defined in the parsed sources are taken for addresses, which gives wrong selectors for
+	// structs, enums and user-defined value types, so generated definitions must not depend on them.
+	if len(contract.Unresolved) > 0 {
+		return nil, fmt.Errorf("contract %s in %s uses types not defined in the parsed sources: %s", t.Contract, t.Path, strings.Join(contract.Unresolved, ", "))
+	}
+	return contract, nil
+}
+
+// flattenSource returns the source file preceded by the sources it imports, recursively, so that the parser sees
+// the bases the file inherits from. Files already visited are skipped. Relative imports are resolved against the
+// importing file, and "@openzeppelin/contracts/" imports against the repository contracts directory.
+func flattenSource(root string, path string, visited map[string]bool) ([]byte, error) {
+	if visited[path] {
+		return nil, nil
+	}
+	visited[path] = true
+
+	src, err := readSource(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var toReturn []byte
+	for _, match := range importPattern.FindAllSubmatch(src, -1) {
+		imported := string(match[1])
+
+		var resolved string
+		switch {
+		case strings.HasPrefix(imported, "@openzeppelin/contracts/"):
+			resolved = filepath.Join(root, "contracts", filepath.FromSlash(strings.TrimPrefix(imported, "@openzeppelin/contracts/")))
+		case strings.HasPrefix(imported, "."):
+			resolved = filepath.Join(filepath.Dir(path), filepath.FromSlash(imported))
+		default:
+			return nil, fmt.Errorf("%s: unsupported import %q", path, imported)
+		}
+
+		dependency, err := flattenSource(root, resolved, visited)
+		if err != nil {
+			return nil, err
+		}
+		toReturn = append(toReturn, dependency...)
+	}
+
+	toReturn = append(toReturn, src...)
+	return append(toReturn, '\n'), nil
+}
+
+// readSource reads the source file at the provided path.
+func readSource(path string) ([]byte, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failure to read source: %w", err)
+	}
+	return src, nil
+}
+
+// writeStandard writes the registration of the contract members as the definition of the target standard.
+// The ABI lists every overload of overloaded functions, while the functions keep their first declaration only, as
+// standard members are matched by their name.
+func writeStandard(buf *bytes.Buffer, t target, contract *solidity.Contract) error {
+	abi := make([]abiEntry, 0, len(contract.Functions)+len(contract.Events)+len(contract.Errors))
+	functions := make([]shared.Function, 0, len(contract.Functions))
+
+	declared := make(map[string]bool, len(contract.Functions))
+	for _, fn := range contract.Functions {
+		if !declared[fn.Name] {
+			declared[fn.Name] = true
+			functions = append(functions, fn)
+		}
+
+		outputs := make([]abiParameter, 0, len(fn.Outputs))
+		for _, output := range fn.Outputs {
+			outputs = append(outputs, abiParam(shared.Input{Name: output.Name, Type: output.Type, Components: output.Components}, false))
+		}
+		abi = append(abi, abiEntry{
+			Inputs:          abiParams(fn.Inputs, false),
+			Name:            fn.Name,
+			Outputs:         outputs,
+			StateMutability: string(fn.StateMutability),
+			Type:            "function",
+		})
+	}
+	for _, event := range contract.Events {
+		anonymous := false
+		abi = append(abi, abiEntry{Anonymous: &anonymous, Inputs: abiParams(event.Inputs, true), Name: event.Name, Type: "event"})
+	}
+	for _, e := range contract.Errors {
+		abi = append(abi, abiEntry{Inputs: abiParams(e.Inputs, false), Name: e.Name, Type: "error"})
+	}
+
+	encoded, err := json.Marshal(abi)
+	if err != nil {
+		return fmt.Errorf("failure to encode abi: %w", err)
+	}
+
+	fmt.Fprintf(buf, "\t// %s out of %s.\n", t.Contract, t.Path)
+	fmt.Fprintf(buf, "\tregister(%q, shared.ContractStandard{\n", t.Standard)
+	fmt.Fprintf(buf, "\t\tABI: `%s`,\n", encoded)
+	if len(functions) > 0 {
+		fmt.Fprintf(buf, "\t\tFunctions: []shared.Function{\n")
+		for _, fn := range functions {
+			fmt.Fprintf(buf, "\t\t\tshared.NewFunction(%q, %s, %s),\n", fn.Name, goInputs(fn.Inputs, false, false), goOutputs(fn.Outputs))
+		}
+		fmt.Fprintf(buf, "\t\t},\n")
+	}
+	if len(contract.Events) > 0 {
+		fmt.Fprintf(buf, "\t\tEvents: []shared.Event{\n")
+		for _, event := range contract.Events {
+			fmt.Fprintf(buf, "\t\t\tshared.NewEvent(%q, %s, nil),\n", event.Name, goInputs(event.Inputs, true, false))
+		}
+		fmt.Fprintf(buf, "\t\t},\n")
+	}
+	if len(contract.Errors) > 0 {
+		fmt.Fprintf(buf, "\t\tErrors: []shared.Error{\n")
+		for _, e := range contract.Errors {
+			fmt.Fprintf(buf, "\t\t\tshared.NewError(%q, %s),\n", e.Name, goInputs(e.Inputs, false, false))
+		}
+		fmt.Fprintf(buf, "\t\t},\n")
+	}
+	fmt.Fprintf(buf, "\t})\n")
+	return nil
+}
+
+// abiParams converts the inputs into JSON ABI parameters, marking indexed event parameters.
+func abiParams(inputs []shared.Input, event bool) []abiParameter {
+	toReturn := make([]abiParameter, 0, len(inputs))
+	for _, input := range inputs {
+		toReturn = append(toReturn, abiParam(input, event))
+	}
+	return toReturn
+}
+
+// abiParam converts the input into a JSON ABI parameter, including its tuple components.
+func abiParam(input shared.Input, event bool) abiParameter {
+	toReturn := abiParameter{Name: input.Name, Type: input.Type}
+	if event {
+		indexed := input.Indexed
+		toReturn.Indexed = &indexed
+	}
+	for _, component := range input.Components {
+		toReturn.Components = append(toReturn.Components, abiParam(component, false))
+	}
+	return toReturn
+}
+
+// goInputs returns the Go expression of the inputs, e.g. []shared.Input{{Type: shared.TypeAddress}}. Names are
+// only kept for tuple components, matching the hand-written directory definitions.
+func goInputs(inputs []shared.Input, event bool, named bool) string {
+	if len(inputs) == 0 {
+		return "nil"
+	}
+
+	items := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		fields := make([]string, 0, 4)
+		if named && input.Name != "" {
+			fields = append(fields, fmt.Sprintf("Name: %q", input.Name))
+		}
+		fields = append(fields, "Type: "+goType(input.Type))
+		if len(input.Components) > 0 {
+			fields = append(fields, "Components: "+goInputs(input.Components, false, true))
+		}
+		if event && input.Indexed {
+			fields = append(fields, "Indexed: true")
+		}
+		items = append(items, "{"+strings.Join(fields, ", ")+"}")
+	}
+	return "[]shared.Input{" + strings.Join(items, ", ") + "}"
+}
+
+// goOutputs returns the Go expression of the outputs, e.g. []shared.Output{{Type: shared.TypeBool}}.
+func goOutputs(outputs []shared.Output) string {
+	if len(outputs) == 0 {
+		return "nil"
+	}
+
+	items := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		fields := []string{"Type: " + goType(output.Type)}
+		if len(output.Components) > 0 {
+			fields = append(fields, "Components: "+goInputs(output.Components, false, true))
+		}
+		items = append(items, "{"+strings.Join(fields, ", ")+"}")
+	}
+	return "[]shared.Output{" + strings.Join(items, ", ") + "}"
+}
+
+// goType returns the shared package constant of the type, or the quoted type if there is no such constant.
+func goType(typ string) string {
+	if constant, ok := typeConstants[typ]; ok {
+		return "shared." + constant
+	}
+	return fmt.Sprintf("%q", typ)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestParseTarget(t *testing.T) {
+	testCases := []struct {
+		target    target
+		functions []string
+		events    []string
+		errors    []string
+	}{
+		{
+			target:    target{Standard: "OZOWNABLE", Path: "contracts/access/Ownable2Step.sol", Contract: "Ownable2Step", Inherited: true},
+			functions: []string{"pendingOwner()", "transferOwnership(address)", "acceptOwnership()", "owner()", "renounceOwnership()"},
+			events:    []string{"OwnershipTransferStarted(address,address)", "OwnershipTransferred(address,address)"},
+			errors:    []string{"OwnableUnauthorizedAccount(address)", "OwnableInvalidOwner(address)"},
+		},
+		{
+			target:    target{Standard: "ERC20", Path: "contracts/token/ERC20/extensions/IERC20Metadata.sol", Contract: "IERC20Metadata", Inherited: true},
+			functions: []string{"name()", "symbol()", "decimals()", "totalSupply()", "balanceOf(address)", "transfer(address,uint256)", "allowance(address,address)", "approve(address,uint256)", "transferFrom(address,address,uint256)"},
+			events:    []string{"Transfer(address,address,uint256)", "Approval(address,address,uint256)"},
+		},
+		{
+			target:    target{Standard: "ERC2981", Path: "contracts/interfaces/IERC2981.sol", Contract: "IERC2981"},
+			functions: []string{"royaltyInfo(uint256,uint256)"},
+		},
+		{
+			target: target{Standard: "ERC1155ERRORS", Path: "contracts/interfaces/draft-IERC6093.sol", Contract: "IERC1155Errors"},
+			errors: []string{
+				"ERC1155InsufficientBalance(address,uint256,uint256,uint256)", "ERC1155InvalidSender(address)", "ERC1155InvalidReceiver(address)",
+				"ERC1155MissingApprovalForAll(address,address)", "ERC1155InvalidApprover(address)", "ERC1155InvalidOperator(address)",
+				"ERC1155InvalidArrayLength(uint256,uint256)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.target.Standard.String(), func(t *testing.T) {
+			contract, err := parseTarget("testdata", testCase.target)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			functions := make([]string, 0, len(contract.Functions))
+			for _, fn := range contract.Functions {
+				functions = append(functions, fn.Signature())
+			}
+			events := make([]string, 0, len(contract.Events))
+			for _, event := range contract.Events {
+				events = append(events, event.Signature())
+			}
+			errors := make([]string, 0, len(contract.Errors))
+			for _, e := range contract.Errors {
+				errors = append(errors, e.Signature())
+			}
+
+			assert.ElementsMatch(t, testCase.functions, functions)
+			assert.ElementsMatch(t, testCase.events, events)
+			assert.ElementsMatch(t, testCase.errors, errors)
+		})
+	}
+
+	_, err := parseTarget("testdata", target{Standard: "ERC1155", Path: "contracts/token/ERC1155/IERC1155.sol", Contract: "IERC1155"})
+	assert.Error(t, err)
+
+	_, err = parseTarget("testdata", target{Standard: "ERC721ERRORS", Path: "contracts/interfaces/draft-IERC6093.sol", Contract: "IERC721Errors"})
+	assert.ErrorContains(t, err, "contract IERC721Errors not found")
+
+	// Imported structs would be taken for addresses, giving wrong selectors.
+	root := t.TempDir()
+	src := `import {PoolKey} from "./PoolKey.sol"; interface IHooks { function beforeInitialize(address sender, PoolKey calldata key) external returns (bytes4); }`
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "IHooks.sol"), []byte(src), 0o644))
+	_, err = parseTarget(root, target{Standard: "UNISWAPV4HOOKS", Path: "IHooks.sol", Contract: "IHooks"})
+	assert.ErrorContains(t, err, "uses types not defined in the parsed sources: PoolKey")
+}
+
+func TestGenerate(t *testing.T) {
+	generated, err := generate("testdata", "openzeppelin", []target{
+		{Standard: "OZOWNABLE", Path: "contracts/access/Ownable2Step.sol", Contract: "Ownable2Step", Inherited: true},
+		{Standard: "ERC2981", Path: "contracts/interfaces/IERC2981.sol", Contract: "IERC2981"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = parser.ParseFile(token.NewFileSet(), "standards_gen.go", generated, parser.AllErrors)
+	assert.NoError(t, err)
+
+	source := string(generated)
+	assert.Contains(t, source, "// Code generated by ozgen from the OpenZeppelin contracts sources in testdata. DO NOT EDIT.")
+	assert.Contains(t, source, "package openzeppelin")
+	assert.Contains(t, source, `register("OZOWNABLE", shared.ContractStandard{`)
+	assert.Contains(t, source, `shared.NewFunction("pendingOwner", nil, []shared.Output{{Type: shared.TypeAddress}}),`)
+	assert.Contains(t, source, `shared.NewEvent("OwnershipTransferred", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}}, nil),`)
+	assert.Contains(t, source, `shared.NewError("OwnableInvalidOwner", []shared.Input{{Type: shared.TypeAddress}}),`)
+	assert.Contains(t, source, `shared.NewFunction("royaltyInfo", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}),`)
+
+	// Generated ABIs resolve the state mutability of the generated functions.
+	abis := regexp.MustCompile("ABI: `([^`]*)`").FindAllStringSubmatch(source, -1)
+	if assert.Len(t, abis, 2) {
+		matcher, err := shared.NewContractMatcherFromABI("Ownable2Step", []byte(abis[0][1]))
+		assert.NoError(t, err)
+
+		mutability := map[string]shared.StateMutability{}
+		for _, fn := range matcher.Functions {
+			mutability[fn.Signature()] = fn.StateMutability
+		}
+		assert.Equal(t, shared.StateMutabilityView, mutability["owner()"])
+		assert.Equal(t, shared.StateMutabilityNonPayable, mutability["acceptOwnership()"])
+		assert.Len(t, matcher.Events, 2)
+		assert.Len(t, matcher.Errors, 2)
+	}
+
+	// Generated ABIs name parameters as declared and list every overload, while functions keep the first one.
+	assert.Contains(t, source, `"inputs":[{"name":"tokenId","type":"uint256"},{"name":"salePrice","type":"uint256"}],"name":"royaltyInfo","outputs":[{"name":"receiver","type":"address"},{"name":"royaltyAmount","type":"uint256"}]`)
+
+	root := t.TempDir()
+	src := `interface IERC721 { function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external; function safeTransferFrom(address from, address to, uint256 tokenId) external; }`
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "IERC721.sol"), []byte(src), 0o644))
+	generated, err = generate(root, "openzeppelin", []target{{Standard: "ERC721", Path: "IERC721.sol", Contract: "IERC721"}})
+	if assert.NoError(t, err) {
+		abis := regexp.MustCompile("ABI: `([^`]*)`").FindStringSubmatch(string(generated))
+		if assert.NotNil(t, abis) {
+			matcher, err := shared.NewContractMatcherFromABI("IERC721", []byte(abis[1]))
+			if assert.NoError(t, err) && assert.Len(t, matcher.Functions, 2) {
+				assert.Equal(t, "safeTransferFrom(address,address,uint256,bytes)", matcher.Functions[0].Signature())
+				assert.Equal(t, "safeTransferFrom(address,address,uint256)", matcher.Functions[1].Signature())
+			}
+		}
+		assert.Equal(t, 1, strings.Count(string(generated), `shared.NewFunction("safeTransferFrom"`))
+	}
+
+	_, err = generate("testdata", "openzeppelin", []target{{Standard: "ERC1155", Path: "contracts/token/ERC1155/IERC1155.sol", Contract: "IERC1155"}})
+	assert.ErrorContains(t, err, "standard ERC1155")
+}
+
+// TestGeneratedDefinitions checks that the committed definitions match the output of the go:generate directive of
+// the directory/openzeppelin package, which runs from the package directory, two levels below the repository root
+// just like this package.
+func TestGeneratedDefinitions(t *testing.T) {
+	pkg := filepath.Join("..", "..", "directory", "openzeppelin")
+	source, err := os.ReadFile(filepath.Join(pkg, "openzeppelin.go"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	directive := regexp.MustCompile(`(?m)^//go:generate go run \.\./\.\./internal/ozgen (.*)$`).FindSubmatch(source)
+	if !assert.NotNil(t, directive, "go:generate directive not found") {
+		return
+	}
+
+	args := map[string]string{"-package": "openzeppelin"}
+	fields := strings.Fields(string(directive[1]))
+	for i := 0; i+1 < len(fields); i += 2 {
+		args[fields[i]] = fields[i+1]
+	}
+
+	selected, err := selectTargets(targets, strings.Split(args["-standards"], ","))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	generated, err := generate(args["-src"], args["-package"], selected)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	committed, err := os.ReadFile(filepath.Join(pkg, args["-o"]))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(generated), string(committed), "standards_gen.go is stale, run go generate ./directory/openzeppelin")
+	}
+}
+
+func TestSelectTargets(t *testing.T) {
+	selected, err := selectTargets(targets, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, targets, selected)
+
+	selected, err = selectTargets(targets, []string{"OZOWNABLE", "ERC20"})
+	if assert.NoError(t, err) && assert.Len(t, selected, 2) {
+		assert.Equal(t, shared.Standard("ERC20"), selected[0].Standard)
+		assert.Equal(t, shared.Standard("OZOWNABLE"), selected[1].Standard)
+	}
+
+	_, err = selectTargets(targets, []string{"ERC20", "ERC9999"})
+	assert.ErrorContains(t, err, "no target for standard ERC9999")
+}
+
+func TestGoType(t *testing.T) {
+	assert.Equal(t, "shared.TypeUint256", goType(shared.TypeUint256))
+	assert.Equal(t, `"uint208"`, goType("uint208"))
+	assert.Equal(t, "nil", goInputs(nil, false, false))
+	assert.Equal(t, "[]shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: \"owner\", Type: shared.TypeAddress}}}}",
+		goInputs([]shared.Input{{Type: shared.TypeTuple, Components: []shared.Input{{Name: "owner", Type: shared.TypeAddress}}}}, false, false))
+}
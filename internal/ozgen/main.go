@@ -0,0 +1,55 @@
+// Command ozgen generates standard definitions (ABI, functions, events and errors) out of OpenZeppelin contracts
+// sources, either the full sources pinned by the libs/openzeppelin submodule or the subset vendored as test fixtures
+// in internal/ozgen/testdata. Sources are parsed with the solgo Solidity grammar through the solidity package. It is
+// invoked by go generate within the directory/openzeppelin package, which generates the committed definitions out of
+// the fixtures:
+//
+//	go generate ./directory/openzeppelin
+//
+// Usage:
+//
+//	ozgen [-src dir] [-o file] [-package name] [-standards list]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	src := flag.String("src", "libs/openzeppelin", "root of the OpenZeppelin contracts repository")
+	out := flag.String("o", "standards_gen.go", "generated Go source file")
+	pkg := flag.String("package", "openzeppelin", "package of the generated Go source file")
+	only := flag.String("standards", "", "comma separated standards to generate, all targets if empty")
+	flag.Parse()
+
+	if _, err := os.Stat(*src + "/contracts"); err != nil {
+		fmt.Fprintf(os.Stderr, "ozgen: OpenZeppelin sources not found in %s, check out the submodule with "+
+			"'git submodule update --init libs/openzeppelin'\n", *src)
+		os.Exit(1)
+	}
+
+	var standards []string
+	if *only != "" {
+		standards = strings.Split(*only, ",")
+	}
+
+	selected, err := selectTargets(targets, standards)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ozgen: %s\n", err)
+		os.Exit(1)
+	}
+
+	generated, err := generate(*src, *pkg, selected)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ozgen: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, generated, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "ozgen: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,132 @@
+package standards
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// Log represents an event log emitted by a contract, reduced to what standard inference relies on.
+type Log struct {
+	Topic0 string `json:"topic0"` // Hex encoded event topic, the keccak256 hash of the event signature.
+	Topics int    `json:"topics"` // Number of log topics including topic0, or zero when unknown.
+}
+
+// DetectLogs infers standards implemented by a contract out of the event logs it emitted. Each standard sharing
+// at least one event topic with the logs is scored by the share of its events observed, so the discovery tokens
+// are the standard events rather than members of an ABI.
+//
+// Logs carrying their number of topics are only matched against events with the same number of indexed
+// parameters. This tells apart events sharing a signature, such as the ERC-20 and ERC-721 Transfer, where only
+// the latter indexes the token id. Events observed exclusively with a different number of topics are not
+// discovered and are reported as topic count deviations instead.
+//
+// Parameters:
+// - logs: Observed event logs, in any order and possibly repeated.
+//
+// Returns:
+// - []Discovery: Discovered standards, ordered by confidence points in descending order.
+// - error: An error if a log holds a malformed topic or a negative topic count.
+func (d *Detector) DetectLogs(logs []Log) ([]shared.Discovery, error) {
+	observed := make(map[string][]int, len(logs))
+	for _, log := range logs {
+		topic, err := normalizeTopic(log.Topic0)
+		if err != nil {
+			return nil, err
+		}
+		if log.Topics < 0 || log.Topics > 4 {
+			return nil, fmt.Errorf("%w: topic count %d of %s is out of range", errors.ErrInvalidLog, log.Topics, topic)
+		}
+		if !contains(observed[topic], log.Topics) {
+			observed[topic] = append(observed[topic], log.Topics)
+		}
+	}
+
+	candidates := make(map[shared.Standard]bool)
+	for topic := range observed {
+		for _, s := range index["event:"+topic] {
+			candidates[s] = true
+		}
+	}
+
+	toReturn := make([]shared.Discovery, 0)
+	for _, eip := range d.eips {
+		if !candidates[eip.GetType()] {
+			continue
+		}
+		if discovery, found := logsConfidenceCheck(eip, observed); found {
+			toReturn = append(toReturn, discovery)
+		}
+	}
+
+	sort.SliceStable(toReturn, func(i, j int) bool {
+		return toReturn[i].ConfidencePoints > toReturn[j].ConfidencePoints
+	})
+
+	return toReturn, nil
+}
+
+// logsConfidenceCheck scores the standard events against the observed topics and their topic counts, where
+// a zero topic count matches any event of the topic.
+func logsConfidenceCheck(eip shared.EIP, observed map[string][]int) (shared.Discovery, bool) {
+	events := eip.GetEvents()
+	toReturn := shared.Discovery{
+		Standard:      eip.GetType(),
+		Category:      eip.GetStandard().Category,
+		MaximumTokens: len(events),
+		Contract:      &shared.ContractMatcher{Events: make([]shared.Event, 0, len(events))},
+		Deviations:    make([]shared.Deviation, 0),
+	}
+
+	for _, event := range events {
+		counts, ok := observed[event.Topic()]
+		if !ok {
+			continue
+		}
+
+		expected := 1
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				expected++
+			}
+		}
+
+		if !contains(counts, 0) && !contains(counts, expected) {
+			for _, count := range counts {
+				toReturn.Deviations = append(toReturn.Deviations, shared.Deviation{
+					Kind:     shared.DeviationTopicCount,
+					Member:   event.Signature(),
+					Expected: fmt.Sprintf("%d topics", expected),
+					Actual:   fmt.Sprintf("%d topics", count),
+				})
+			}
+			continue
+		}
+
+		event.Matched = true
+		toReturn.DiscoveredTokens++
+		toReturn.Contract.Events = append(toReturn.Contract.Events, event)
+	}
+
+	if toReturn.DiscoveredTokens == 0 {
+		return toReturn, false
+	}
+
+	toReturn.ConfidencePoints = float64(toReturn.DiscoveredTokens) / float64(toReturn.MaximumTokens)
+	toReturn.Confidence, toReturn.Threshold = confidence.CalculateDiscoveryConfidence(toReturn.ConfidencePoints)
+	return toReturn, true
+}
+
+// normalizeTopic returns the lowercase, 0x prefixed form of the hex encoded topic, failing unless it is 32 bytes long.
+func normalizeTopic(topic string) (string, error) {
+	topic = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(topic), "0x"))
+	if decoded, err := hex.DecodeString(topic); err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("%w: topic %q is not a 32-byte hex value", errors.ErrInvalidLog, topic)
+	}
+	return "0x" + topic, nil
+}
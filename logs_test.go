@@ -0,0 +1,100 @@
+package standards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestDetectorDetectLogs(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	transfer := shared.SignatureTopic("Transfer(address,address,uint256)")
+	approval := shared.SignatureTopic("Approval(address,address,uint256)")
+	approvalForAll := shared.SignatureTopic("ApprovalForAll(address,address,bool)")
+
+	discovered := func(discoveries []shared.Discovery) map[shared.Standard]shared.Discovery {
+		toReturn := make(map[shared.Standard]shared.Discovery, len(discoveries))
+		for _, discovery := range discoveries {
+			toReturn[discovery.Standard] = discovery
+		}
+		return toReturn
+	}
+
+	t.Run("ERC20", func(t *testing.T) {
+		discoveries, err := detector.DetectLogs([]Log{
+			{Topic0: transfer, Topics: 3},
+			{Topic0: transfer, Topics: 3},
+			{Topic0: approval, Topics: 3},
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, discoveries)
+		assert.Equal(t, ERC20, discoveries[0].Standard)
+		assert.Equal(t, shared.PerfectConfidence, discoveries[0].Confidence)
+		assert.Equal(t, shared.CategoryToken, discoveries[0].Category)
+		assert.Len(t, discoveries[0].Contract.Events, 2)
+		assert.NotContains(t, discovered(discoveries), ERC721)
+	})
+
+	t.Run("ERC721", func(t *testing.T) {
+		discoveries, err := detector.DetectLogs([]Log{
+			{Topic0: transfer, Topics: 4},
+			{Topic0: approvalForAll, Topics: 3},
+		})
+		assert.NoError(t, err)
+
+		byStandard := discovered(discoveries)
+		assert.Contains(t, byStandard, ERC721)
+		assert.NotContains(t, byStandard, ERC20)
+		assert.Equal(t, ERC721, discoveries[0].Standard)
+		assert.Equal(t, 2, byStandard[ERC721].DiscoveredTokens)
+	})
+
+	t.Run("UnknownTopicCount", func(t *testing.T) {
+		discoveries, err := detector.DetectLogs([]Log{{Topic0: transfer}, {Topic0: approval}})
+		assert.NoError(t, err)
+
+		byStandard := discovered(discoveries)
+		assert.Contains(t, byStandard, ERC20)
+		assert.Contains(t, byStandard, ERC721)
+		assert.Equal(t, ERC20, discoveries[0].Standard)
+		assert.Greater(t, byStandard[ERC20].ConfidencePoints, byStandard[ERC721].ConfidencePoints)
+	})
+
+	t.Run("TopicCountDeviation", func(t *testing.T) {
+		discoveries, err := detector.DetectLogs([]Log{
+			{Topic0: transfer, Topics: 4},
+			{Topic0: approval, Topics: 3},
+		})
+		assert.NoError(t, err)
+
+		erc20, ok := discovered(discoveries)[ERC20]
+		assert.True(t, ok)
+		assert.Equal(t, 1, erc20.DiscoveredTokens)
+		assert.Equal(t, []shared.Deviation{{
+			Kind:     shared.DeviationTopicCount,
+			Member:   "Transfer(address,address,uint256)",
+			Expected: "3 topics",
+			Actual:   "4 topics",
+		}}, erc20.Deviations)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		discoveries, err := detector.DetectLogs([]Log{{Topic0: shared.SignatureTopic("Unknown(uint256)"), Topics: 1}})
+		assert.NoError(t, err)
+		assert.Empty(t, discoveries)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := detector.DetectLogs([]Log{{Topic0: "0x1234"}})
+		assert.ErrorIs(t, err, errors.ErrInvalidLog)
+
+		_, err = detector.DetectLogs([]Log{{Topic0: transfer, Topics: 5}})
+		assert.ErrorIs(t, err, errors.ErrInvalidLog)
+	})
+}
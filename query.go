@@ -0,0 +1,244 @@
+package standards
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// Query holds criteria registered standards are searched by, see QueryStandards. All criteria must be satisfied,
+// while empty criteria match every standard.
+type Query struct {
+	StandardFilter
+
+	Text     string          // Case-insensitive part of the standard type or name, e.g. "uniswap".
+	Function string          // Function selector, signature or name, e.g. "0xa9059cbb" or "transfer(address,uint256)".
+	Event    string          // Event topic, signature or name, e.g. "Transfer(address,address,uint256)".
+	Error    string          // Error selector, signature or name, e.g. "OwnableUnauthorizedAccount".
+	Extends  shared.Standard // Parent standard extended by the standard, e.g. ERC721.
+}
+
+// QueryStandards retrieves registered Ethereum standards satisfying the query in a sorted order. Members are looked
+// up by their selector or topic in the registry inverted index, with signatures normalized first, so that
+// "Transfer(address indexed from, address indexed to, uint value)" finds every standard emitting Transfer.
+// Members provided by their name alone match any standard declaring a member of that name.
+//
+// Parameters:
+// - query: The criteria standards are searched by.
+//
+// Returns:
+// - []EIP: A slice of Ethereum standards satisfying the query.
+// - error: An error if the query holds a malformed selector or topic.
+func QueryStandards(query Query) ([]shared.EIP, error) {
+	members := make([]func(shared.EIP) bool, 0, 3)
+	for _, member := range []struct {
+		kind  string
+		value string
+		size  int
+	}{
+		{kind: "function", value: query.Function, size: 4},
+		{kind: "event", value: query.Event, size: 32},
+		{kind: "error", value: query.Error, size: 4},
+	} {
+		if member.value == "" {
+			continue
+		}
+		match, err := memberMatcher(member.kind, member.value, member.size)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, match)
+	}
+
+	text := strings.ToLower(query.Text)
+
+	eips := make([]shared.EIP, 0)
+	for _, eip := range FilterStandards(query.StandardFilter) {
+		if text != "" && !strings.Contains(strings.ToLower(eip.GetType().String()), text) &&
+			!strings.Contains(strings.ToLower(eip.GetName()), text) {
+			continue
+		}
+		if query.Extends != "" && !contains(eip.GetStandard().Extends, query.Extends) {
+			continue
+		}
+
+		matched := true
+		for _, match := range members {
+			if !match(eip) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			eips = append(eips, eip)
+		}
+	}
+
+	return eips, nil
+}
+
+// memberMatcher returns a function reporting whether a standard declares the member of the provided kind,
+// identified by its hex encoded selector or topic of the provided size in bytes, its signature or its name.
+func memberMatcher(kind string, value string, size int) (func(shared.EIP) bool, error) {
+	value = strings.TrimSpace(value)
+
+	var key string
+	switch {
+	case strings.HasPrefix(value, "0x"):
+		if decoded, err := hex.DecodeString(value[2:]); err != nil || len(decoded) != size {
+			return nil, fmt.Errorf("%w: %s %q is not a %d-byte hex value", errors.ErrInvalidQuery, kind, value, size)
+		}
+		key = kind + ":" + strings.ToLower(value)
+	case strings.Contains(value, "("):
+		if kind == "event" {
+			key = kind + ":" + shared.SignatureTopic(value)
+		} else {
+			key = kind + ":" + shared.SignatureSelector(value)
+		}
+	default:
+		return func(eip shared.EIP) bool { return declaresName(eip, kind, value) }, nil
+	}
+
+	return func(eip shared.EIP) bool { return contains(index[key], eip.GetType()) }, nil
+}
+
+// declaresName reports whether the standard declares a member of the provided kind and name.
+func declaresName(eip shared.EIP, kind string, name string) bool {
+	switch kind {
+	case "function":
+		for _, fn := range eip.GetFunctions() {
+			if fn.Name == name {
+				return true
+			}
+		}
+	case "event":
+		for _, event := range eip.GetEvents() {
+			if event.Name == name {
+				return true
+			}
+		}
+	case "error":
+		for _, e := range eip.GetErrors() {
+			if e.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DependencyKind represents the kind of a dependency between standards.
+type DependencyKind string
+
+const (
+	DependencyRequires DependencyKind = "requires" // Standard requires the other one, see ContractStandard.Requires.
+	DependencyExtends  DependencyKind = "extends"  // Standard extends the other one, see ContractStandard.Extends.
+)
+
+// DependencyNode represents a standard within the dependency graph.
+type DependencyNode struct {
+	Standard   shared.Standard `json:"standard"`
+	Name       string          `json:"name,omitempty"`
+	Category   shared.Category `json:"category,omitempty"`
+	Registered bool            `json:"registered"` // Standards only referenced as dependencies are not registered.
+}
+
+// DependencyEdge represents a dependency of a standard on another one.
+type DependencyEdge struct {
+	From shared.Standard `json:"from"`
+	To   shared.Standard `json:"to"`
+	Kind DependencyKind  `json:"kind"`
+}
+
+// DependencyGraph represents requires and extends relationships between registered standards.
+type DependencyGraph struct {
+	Nodes []DependencyNode `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+}
+
+// GetDependencyGraph builds the dependency graph of registered standards. Nodes and edges are sorted, so the graph
+// is stable across calls. Standards referenced as dependencies without being registered, such as ERC165, are
+// included as unregistered nodes.
+func GetDependencyGraph() *DependencyGraph {
+	toReturn := &DependencyGraph{Nodes: make([]DependencyNode, 0), Edges: make([]DependencyEdge, 0)}
+	nodes := make(map[shared.Standard]bool)
+
+	for _, eip := range GetSortedRegisteredStandards() {
+		standard := eip.GetStandard()
+		toReturn.Nodes = append(toReturn.Nodes, DependencyNode{Standard: standard.Type, Name: standard.Name, Category: standard.Category, Registered: true})
+		nodes[standard.Type] = true
+
+		for _, required := range standard.Requires {
+			toReturn.Edges = append(toReturn.Edges, DependencyEdge{From: standard.Type, To: required, Kind: DependencyRequires})
+		}
+		for _, extended := range standard.Extends {
+			toReturn.Edges = append(toReturn.Edges, DependencyEdge{From: standard.Type, To: extended, Kind: DependencyExtends})
+		}
+	}
+
+	for _, edge := range toReturn.Edges {
+		if !nodes[edge.To] {
+			toReturn.Nodes = append(toReturn.Nodes, DependencyNode{Standard: edge.To})
+			nodes[edge.To] = true
+		}
+	}
+
+	sort.Slice(toReturn.Nodes, func(i, j int) bool { return toReturn.Nodes[i].Standard < toReturn.Nodes[j].Standard })
+	sort.SliceStable(toReturn.Edges, func(i, j int) bool {
+		a, b := toReturn.Edges[i], toReturn.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.To < b.To
+	})
+
+	return toReturn
+}
+
+// WriteJSON writes the dependency graph into the writer as indented JSON.
+func (g *DependencyGraph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the dependency graph into the writer in the Graphviz DOT format. Extends edges are dashed,
+// while unregistered standards are drawn with a dotted border.
+func (g *DependencyGraph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph standards {\n\trankdir=LR;\n\tnode [shape=box];\n")
+
+	for _, node := range g.Nodes {
+		if !node.Registered {
+			fmt.Fprintf(&b, "\t%s [style=dotted];\n", dotQuote(node.Standard.String()))
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s [label=%s];\n", dotQuote(node.Standard.String()), dotQuote(node.Standard.String()+"\n"+node.Name))
+	}
+
+	for _, edge := range g.Edges {
+		style := ""
+		if edge.Kind == DependencyExtends {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "\t%s -> %s [label=%s%s];\n", dotQuote(edge.From.String()), dotQuote(edge.To.String()), dotQuote(string(edge.Kind)), style)
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote returns the value as a quoted DOT identifier.
+func dotQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
@@ -0,0 +1,78 @@
+package standards
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestQueryStandards(t *testing.T) {
+	loadStandards(t)
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected []shared.Standard
+	}{
+		{name: "EventSignature", query: Query{Event: "Transfer(address,address,uint256)"}, expected: []shared.Standard{ERC20, ERC721}},
+		{name: "EventDeclaration", query: Query{Event: "Transfer(address indexed from, address indexed to, uint value)"}, expected: []shared.Standard{ERC20, ERC721}},
+		{name: "EventTopic", query: Query{Event: "0xDDF252AD1BE2C89B69C2B068FC378DAA952BA7F163C4A11628F55A4DF523B3EF"}, expected: []shared.Standard{ERC20, ERC721}},
+		{name: "FunctionSelector", query: Query{Function: "0x70a08231"}, expected: []shared.Standard{ERC20, ERC4337ENTRYPOINT, ERC721}},
+		{name: "FunctionName", query: Query{Function: "flashLoan"}, expected: []shared.Standard{AAVEV2POOL, AAVEV3POOL, ERC3156}},
+		{name: "ErrorSignature", query: Query{Error: "OwnableUnauthorizedAccount(address)"}, expected: []shared.Standard{OZOWNABLE}},
+		{name: "Extends", query: Query{Extends: ERC1155}, expected: []shared.Standard{ERC2981}},
+		{name: "Text", query: Query{Text: "uniswap v2"}, expected: []shared.Standard{UNISWAPV2, UNISWAPV2FACTORY, UNISWAPV2ROUTER}},
+		{
+			name:     "Combined",
+			query:    Query{StandardFilter: StandardFilter{Categories: []shared.Category{shared.CategoryToken}}, Function: "balanceOf(address)", Event: "Transfer"},
+			expected: []shared.Standard{ERC20, ERC721},
+		},
+		{name: "Unknown", query: Query{Function: "unknownFunction()"}, expected: []shared.Standard{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eips, err := QueryStandards(tt.query)
+			assert.NoError(t, err)
+
+			types := make([]shared.Standard, 0, len(eips))
+			for _, eip := range eips {
+				types = append(types, eip.GetType())
+			}
+			assert.Equal(t, tt.expected, types)
+		})
+	}
+
+	for _, query := range []Query{{Function: "0xa9059c"}, {Event: "0xa9059cbb"}, {Error: "0xzz"}} {
+		_, err := QueryStandards(query)
+		assert.ErrorIs(t, err, errors.ErrInvalidQuery)
+	}
+}
+
+func TestDependencyGraph(t *testing.T) {
+	loadStandards(t)
+
+	graph := GetDependencyGraph()
+	assert.Len(t, graph.Nodes, len(GetRegisteredStandards())+1) // ERC165 is referenced without being registered.
+	assert.Contains(t, graph.Nodes, DependencyNode{Standard: ERC165})
+	assert.Contains(t, graph.Edges, DependencyEdge{From: ERC721, To: ERC165, Kind: DependencyRequires})
+	assert.Contains(t, graph.Edges, DependencyEdge{From: ERC2981, To: ERC1155, Kind: DependencyExtends})
+
+	var b bytes.Buffer
+	assert.NoError(t, graph.WriteDOT(&b))
+	assert.Contains(t, b.String(), "digraph standards {")
+	assert.Contains(t, b.String(), `"ERC165" [style=dotted];`)
+	assert.Contains(t, b.String(), `"ERC721" [label="ERC721\nERC-721 Non-Fungible Token Standard"];`)
+	assert.Contains(t, b.String(), `"ERC2981" -> "ERC1155" [label="extends", style=dashed];`)
+	assert.Contains(t, b.String(), `"ERC721" -> "ERC165" [label="requires"];`)
+
+	b.Reset()
+	assert.NoError(t, graph.WriteJSON(&b))
+	var decoded DependencyGraph
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &decoded))
+	assert.Equal(t, graph, &decoded)
+}
@@ -0,0 +1,147 @@
+package quirks
+
+import "github.com/unpackdev/standards/shared"
+
+// noReturnFunctions holds ERC-20 functions required to return a bool, declared without outputs so that
+// contracts match them regardless of their return values.
+var noReturnFunctions = []shared.Function{
+	shared.NewFunction("transfer", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+	shared.NewFunction("transferFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+	shared.NewFunction("approve", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+}
+
+// catalog is a map that stores definitions of the known quirks indexed by their Quirk identifier.
+// Each definition lists members whose presence indicates the quirk, matched the same way as standards.
+var catalog = map[Quirk]definition{
+	NoReturn: {
+		description: "ERC-20 functions return no bool, so callers expecting one revert (e.g. USDT).",
+		standard: shared.ContractStandard{
+			Name:      "Missing ERC-20 Return Value",
+			Url:       "https://github.com/d-xo/weird-erc20#missing-return-values",
+			Type:      shared.Standard(NoReturn),
+			Functions: noReturnFunctions,
+		},
+		minimum: shared.MediumConfidence,
+		check:   missingReturn,
+	},
+	ApproveRaceProtection: {
+		description: "Allowance can be changed relatively, mitigating the approve front-running race.",
+		standard: shared.ContractStandard{
+			Name: "Approve Race Protection",
+			Url:  "https://docs.openzeppelin.com/contracts/4.x/api/token/erc20#ERC20-increaseAllowance-address-uint256-",
+			Type: shared.Standard(ApproveRaceProtection),
+			Functions: []shared.Function{
+				shared.NewFunction("increaseAllowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+				shared.NewFunction("decreaseAllowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			},
+		},
+		minimum: shared.MediumConfidence,
+	},
+	Blocklist: {
+		description: "Privileged accounts can block addresses from sending or receiving tokens (e.g. USDC, USDT).",
+		standard: shared.ContractStandard{
+			Name: "Token Blocklist",
+			Url:  "https://github.com/d-xo/weird-erc20#tokens-with-blocklists",
+			Type: shared.Standard(Blocklist),
+			Functions: []shared.Function{
+				shared.NewFunction("isBlacklisted", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+				shared.NewFunction("blacklist", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewFunction("unBlacklist", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewFunction("getBlackListStatus", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+				shared.NewFunction("addBlackList", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewFunction("removeBlackList", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			},
+			Events: []shared.Event{
+				shared.NewEvent("Blacklisted", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+				shared.NewEvent("UnBlacklisted", []shared.Input{{Type: shared.TypeAddress, Indexed: true}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+	Pausable: {
+		description: "Privileged accounts can pause all transfers.",
+		standard: shared.ContractStandard{
+			Name: "Pausable",
+			Url:  "https://docs.openzeppelin.com/contracts/4.x/api/security#Pausable",
+			Type: shared.Standard(Pausable),
+			Functions: []shared.Function{
+				shared.NewFunction("paused", nil, []shared.Output{{Type: shared.TypeBool}}),
+				shared.NewFunction("pause", nil, nil),
+				shared.NewFunction("unpause", nil, nil),
+			},
+			Events: []shared.Event{
+				shared.NewEvent("Paused", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewEvent("Unpaused", []shared.Input{{Type: shared.TypeAddress}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+	Mintable: {
+		description: "Privileged accounts can mint new tokens, diluting holders.",
+		standard: shared.ContractStandard{
+			Name: "Mint Authority",
+			Url:  "https://docs.openzeppelin.com/contracts/4.x/api/token/erc20#ERC20PresetMinterPauser",
+			Type: shared.Standard(Mintable),
+			Functions: []shared.Function{
+				shared.NewFunction("mint", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+				shared.NewFunction("MINTER_ROLE", nil, []shared.Output{{Type: shared.TypeBytes32}}),
+				shared.NewFunction("configureMinter", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			},
+			Events: []shared.Event{
+				shared.NewEvent("Mint", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+	Burnable: {
+		description: "Tokens can be burned, by their holders or privileged accounts.",
+		standard: shared.ContractStandard{
+			Name: "Burn Authority",
+			Url:  "https://docs.openzeppelin.com/contracts/4.x/api/token/erc20#ERC20Burnable",
+			Type: shared.Standard(Burnable),
+			Functions: []shared.Function{
+				shared.NewFunction("burn", []shared.Input{{Type: shared.TypeUint256}}, nil),
+				shared.NewFunction("burnFrom", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, nil),
+			},
+			Events: []shared.Event{
+				shared.NewEvent("Burn", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+	Rebasing: {
+		description: "Balances change without transfers, as they are derived from shares or a scaling factor (e.g. stETH, aTokens, AMPL).",
+		standard: shared.ContractStandard{
+			Name: "Rebasing Token",
+			Url:  "https://github.com/d-xo/weird-erc20#balance-modifications-outside-of-transfers-rebasingairdrops",
+			Type: shared.Standard(Rebasing),
+			Functions: []shared.Function{
+				shared.NewFunction("sharesOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+				shared.NewFunction("getSharesByPooledEth", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+				shared.NewFunction("getPooledEthByShares", []shared.Input{{Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeUint256}}),
+				shared.NewFunction("scaledBalanceOf", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeUint256}}),
+				shared.NewFunction("rebase", []shared.Input{{Type: shared.TypeUint256}, {Type: shared.TypeInt256}}, []shared.Output{{Type: shared.TypeUint256}}),
+			},
+			Events: []shared.Event{
+				shared.NewEvent("TransferShares", []shared.Input{{Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeAddress, Indexed: true}, {Type: shared.TypeUint256}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+	FeeOnTransfer: {
+		description: "Transfers may charge a fee, so recipients receive less than the transferred amount.",
+		standard: shared.ContractStandard{
+			Name: "Fee on Transfer",
+			Url:  "https://github.com/d-xo/weird-erc20#fee-on-transfer",
+			Type: shared.Standard(FeeOnTransfer),
+			Functions: []shared.Function{
+				shared.NewFunction("isExcludedFromFee", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+				shared.NewFunction("excludeFromFee", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewFunction("includeInFee", []shared.Input{{Type: shared.TypeAddress}}, nil),
+				shared.NewFunction("setTaxFeePercent", []shared.Input{{Type: shared.TypeUint256}}, nil),
+				shared.NewFunction("setFeeRate", []shared.Input{{Type: shared.TypeUint256}}, nil),
+			},
+		},
+		minimum: shared.LowConfidence,
+	},
+}
@@ -0,0 +1,118 @@
+// Package quirks classifies token contracts by quirks that matter to integrators beyond standard compliance,
+// such as missing return values, blocklists, pausability, mint and burn authority or rebasing balances. Quirks are
+// described by a catalog modelled like the standards directory and matched with the same confidence engine.
+package quirks
+
+import (
+	"sort"
+
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/contracts"
+	"github.com/unpackdev/standards/shared"
+)
+
+// Quirk represents a quirk flag of a token contract.
+type Quirk string
+
+// String returns the string representation of the Quirk.
+func (q Quirk) String() string {
+	return string(q)
+}
+
+const (
+	NoReturn              Quirk = "NO_RETURN"               // transfer, transferFrom or approve return no bool.
+	ApproveRaceProtection Quirk = "APPROVE_RACE_PROTECTION" // increaseAllowance and decreaseAllowance are available.
+	Blocklist             Quirk = "BLOCKLIST"               // Addresses can be blocked, e.g. isBlacklisted.
+	Pausable              Quirk = "PAUSABLE"                // Transfers can be paused.
+	Mintable              Quirk = "MINTABLE"                // Privileged accounts can mint tokens.
+	Burnable              Quirk = "BURNABLE"                // Tokens can be burned.
+	Rebasing              Quirk = "REBASING"                // Balances are derived from shares, e.g. sharesOf.
+	FeeOnTransfer         Quirk = "FEE_ON_TRANSFER"         // Transfers may charge a fee.
+)
+
+// definition describes how a quirk is recognised.
+type definition struct {
+	description string
+	standard    shared.ContractStandard            // Members whose presence indicates the quirk.
+	minimum     shared.ConfidenceLevel             // Minimum confidence of the match to flag the quirk.
+	check       func(*shared.ContractMatcher) bool // Optional check of matched contracts, for quirks that are not about presence.
+	contract    shared.EIP                         // Matched contract built out of the standard, see init.
+}
+
+func init() {
+	for quirk, def := range catalog {
+		def.contract = contracts.NewContract(def.standard)
+		catalog[quirk] = def
+	}
+}
+
+// Match represents a quirk flagged on a contract together with the discovery it was flagged on.
+type Match struct {
+	Quirk       Quirk            `json:"quirk"`
+	Description string           `json:"description"`
+	Discovery   shared.Discovery `json:"discovery"`
+}
+
+// Quirks returns all known quirks sorted by name.
+func Quirks() []Quirk {
+	toReturn := make([]Quirk, 0, len(catalog))
+	for quirk := range catalog {
+		toReturn = append(toReturn, quirk)
+	}
+	sort.Slice(toReturn, func(i, j int) bool { return toReturn[i] < toReturn[j] })
+	return toReturn
+}
+
+// Describe returns the description of the quirk and a boolean indicating whether the quirk is known.
+func Describe(quirk Quirk) (string, bool) {
+	def, ok := catalog[quirk]
+	return def.description, ok
+}
+
+// Detect checks the contract against every known quirk and returns the flagged ones sorted by quirk name.
+func Detect(contract *shared.ContractMatcher, opts confidence.Options) []Match {
+	toReturn := make([]Match, 0)
+	if contract == nil {
+		return toReturn
+	}
+
+	for _, quirk := range Quirks() {
+		def := catalog[quirk]
+
+		discovery, found := confidence.ConfidenceCheckWithOptions(def.contract, contract, opts)
+		if !found || discovery.Confidence < def.minimum {
+			continue
+		}
+		if def.check != nil && !def.check(contract) {
+			continue
+		}
+
+		toReturn = append(toReturn, Match{Quirk: quirk, Description: def.description, Discovery: discovery})
+	}
+
+	return toReturn
+}
+
+// Classify returns quirk flags of the contract sorted by name.
+func Classify(contract *shared.ContractMatcher) []Quirk {
+	matches := Detect(contract, confidence.Options{ScoreOnly: true})
+
+	toReturn := make([]Quirk, 0, len(matches))
+	for _, match := range matches {
+		toReturn = append(toReturn, match.Quirk)
+	}
+	return toReturn
+}
+
+// missingReturn reports whether any of the ERC-20 functions returning a bool is declared without outputs.
+// Contracts built out of bytecode never match, as their outputs are taken from the standard definitions.
+func missingReturn(contract *shared.ContractMatcher) bool {
+	for _, fn := range noReturnFunctions {
+		for _, contractFn := range contract.Functions {
+			if contractFn.Selector() == fn.Selector() && len(contractFn.Outputs) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package quirks
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/bytecode"
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/shared"
+)
+
+func loadContract(t *testing.T, name string) *shared.ContractMatcher {
+	abi, err := os.ReadFile("testdata/" + name + ".json")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	contract, err := shared.NewContractMatcherFromABI(name, abi)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return contract
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []Quirk
+	}{
+		{name: "erc20", expected: []Quirk{}},
+		{name: "usdt", expected: []Quirk{Blocklist, NoReturn, Pausable}},
+		{name: "usdc", expected: []Quirk{ApproveRaceProtection, Blocklist, Burnable, Mintable, Pausable}},
+		{name: "steth", expected: []Quirk{Rebasing}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Classify(loadContract(t, tt.name)))
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	matches := Detect(loadContract(t, "usdt"), confidence.Options{})
+	if !assert.Len(t, matches, 3) {
+		t.FailNow()
+	}
+
+	noReturn := matches[1]
+	assert.Equal(t, NoReturn, noReturn.Quirk)
+	assert.Equal(t, shared.PerfectConfidence, noReturn.Discovery.Confidence)
+	assert.Equal(t, shared.Standard(NoReturn), noReturn.Discovery.Standard)
+	assert.NotNil(t, noReturn.Discovery.Contract)
+
+	description, ok := Describe(NoReturn)
+	assert.True(t, ok)
+	assert.Equal(t, description, noReturn.Description)
+
+	assert.Empty(t, Detect(nil, confidence.Options{}))
+}
+
+func TestClassifyPartialMatch(t *testing.T) {
+	// A single blocklist function is enough to flag the blocklist, while a single of the two allowance
+	// functions still flags approve race protection at medium confidence.
+	contract := &shared.ContractMatcher{
+		Name: "Partial",
+		Functions: []shared.Function{
+			shared.NewFunction("isBlacklisted", []shared.Input{{Type: shared.TypeAddress}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("increaseAllowance", []shared.Input{{Type: shared.TypeAddress}, {Type: shared.TypeUint256}}, []shared.Output{{Type: shared.TypeBool}}),
+			shared.NewFunction("pause", nil, nil),
+		},
+	}
+	assert.Equal(t, []Quirk{ApproveRaceProtection, Blocklist}, Classify(contract))
+}
+
+func TestClassifyBytecode(t *testing.T) {
+	// Bytecode matchers take outputs from standard definitions, so missing return values are never flagged.
+	code, err := bytecode.Decode("0x8063a9059cbb14610100575b8063095ea7b314610100575b806323b872dd14610100575b00")
+	assert.NoError(t, err)
+
+	contract := standards.NewContractMatcherFromBytecode("Token", code)
+	assert.Len(t, contract.Functions, 3)
+	assert.Empty(t, Classify(contract))
+}
+
+func TestQuirks(t *testing.T) {
+	quirks := Quirks()
+	assert.Len(t, quirks, len(catalog))
+	for i := 1; i < len(quirks); i++ {
+		assert.Less(t, quirks[i-1], quirks[i])
+	}
+
+	for _, quirk := range quirks {
+		description, ok := Describe(quirk)
+		assert.True(t, ok)
+		assert.NotEmpty(t, description)
+		assert.Equal(t, shared.Standard(quirk), catalog[quirk].standard.Type)
+	}
+
+	_, ok := Describe(Quirk("UNKNOWN"))
+	assert.False(t, ok)
+}
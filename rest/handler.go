@@ -0,0 +1,241 @@
+// Package rest implements an HTTP/JSON API over the registry of Ethereum standards and the standard detection,
+// for consumers that can't speak gRPC. Responses are encoded the same way as shared.ToJSON and the API is
+// described by the OpenAPI document served at /openapi.json.
+//
+// The Handler serves its routes relative to the root path and can be mounted into an existing mux under
+// any prefix, see Mount.
+package rest
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/bytecode"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// DefaultMaxBodyBytes is the default maximum size of detection request bodies.
+const DefaultMaxBodyBytes = 5 << 20
+
+// OpenAPI holds the OpenAPI document describing the API.
+//
+//go:embed openapi.json
+var OpenAPI []byte
+
+// Options holds the options of the Handler.
+type Options struct {
+	// Detector holds the options used while detecting standards. Strict and fuzzy matching can additionally
+	// be enabled per request.
+	Detector standards.DetectorOptions
+
+	// MaxBodyBytes is the maximum size of detection request bodies. Defaults to DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+}
+
+// DetectABIRequest represents the body of the ABI detection request.
+type DetectABIRequest struct {
+	Name   string          `json:"name"`   // Name of the contract.
+	ABI    json.RawMessage `json:"abi"`    // JSON ABI of the contract.
+	Strict bool            `json:"strict"` // Whether to score function state mutability.
+	Fuzzy  bool            `json:"fuzzy"`  // Whether to match lookalike member names.
+}
+
+// DetectBytecodeRequest represents the body of the bytecode detection request.
+type DetectBytecodeRequest struct {
+	Name     string `json:"name"`     // Name of the contract.
+	Bytecode string `json:"bytecode"` // Hex encoded runtime bytecode of the contract.
+	Strict   bool   `json:"strict"`   // Whether to score function state mutability.
+	Fuzzy    bool   `json:"fuzzy"`    // Whether to match lookalike member names.
+}
+
+// DetectResponse represents the result of a detection request.
+type DetectResponse struct {
+	Contract    string             `json:"contract"`    // Name of the contract.
+	Discoveries []shared.Discovery `json:"discoveries"` // Discovered standards, ordered by confidence.
+}
+
+// ErrorResponse represents the body of a failed request.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler serves the HTTP/JSON API.
+type Handler struct {
+	opts Options
+	mux  *http.ServeMux
+}
+
+// NewHandler creates a new Handler out of the currently registered standards.
+// It returns an error if no standards are registered, see standards.LoadStandards.
+func NewHandler(opts Options) (*Handler, error) {
+	if !standards.StandardsLoaded() {
+		return nil, errors.ErrNoStandardsRegistered
+	}
+
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+
+	h := &Handler{opts: opts, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /standards", h.listStandards)
+	h.mux.HandleFunc("GET /standards/{standard}", h.getStandard)
+	h.mux.HandleFunc("POST /detect/abi", h.detectABI)
+	h.mux.HandleFunc("POST /detect/bytecode", h.detectBytecode)
+	h.mux.HandleFunc("GET /openapi.json", h.openAPI)
+
+	return h, nil
+}
+
+// Mount mounts the handler into the mux under the provided prefix, e.g. "/standards-api".
+// An empty prefix mounts the handler at the root path.
+func Mount(mux *http.ServeMux, prefix string, h *Handler) {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		mux.Handle("/", h)
+		return
+	}
+	mux.Handle(prefix+"/", http.StripPrefix(prefix, h))
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// listStandards serves all registered standards, ordered by their type.
+func (h *Handler) listStandards(w http.ResponseWriter, r *http.Request) {
+	eips := standards.GetSortedRegisteredStandards()
+
+	toReturn := make([]shared.ContractStandard, 0, len(eips))
+	for _, eip := range eips {
+		toReturn = append(toReturn, eip.GetStandard())
+	}
+
+	writeJSON(w, http.StatusOK, toReturn)
+}
+
+// getStandard serves a single registered standard by its case-insensitive type.
+func (h *Handler) getStandard(w http.ResponseWriter, r *http.Request) {
+	name := strings.ToUpper(r.PathValue("standard"))
+
+	eip, found := standards.GetStandard(shared.Standard(name))
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%w: %s", errors.ErrStandardNotFound, name))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, eip.GetStandard())
+}
+
+// detectABI serves detection of standards implemented by the contract out of its JSON ABI.
+func (h *Handler) detectABI(w http.ResponseWriter, r *http.Request) {
+	var req DetectABIRequest
+	if err := h.decode(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(req.ABI) == 0 || string(req.ABI) == "null" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("abi is required"))
+		return
+	}
+
+	contract, err := shared.NewContractMatcherFromABI(req.Name, req.ABI)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	h.detect(w, contract, req.Strict, req.Fuzzy)
+}
+
+// detectBytecode serves detection of standards implemented by the contract out of its runtime bytecode.
+func (h *Handler) detectBytecode(w http.ResponseWriter, r *http.Request) {
+	var req DetectBytecodeRequest
+	if err := h.decode(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	code, err := bytecode.Decode(req.Bytecode)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(code) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bytecode is required"))
+		return
+	}
+
+	h.detect(w, standards.NewContractMatcherFromBytecode(req.Name, code), req.Strict, req.Fuzzy)
+}
+
+// openAPI serves the OpenAPI document describing the API.
+func (h *Handler) openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(OpenAPI)
+}
+
+// detect detects standards of the contract and writes the detection response.
+func (h *Handler) detect(w http.ResponseWriter, contract *shared.ContractMatcher, strict bool, fuzzy bool) {
+	opts := h.opts.Detector
+	opts.Confidence.Strict = opts.Confidence.Strict || strict
+	opts.Confidence.Fuzzy = opts.Confidence.Fuzzy || fuzzy
+
+	detector, err := standards.NewDetector(opts)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	discoveries, err := detector.Detect(contract)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, DetectResponse{Contract: contract.Name, Discoveries: discoveries})
+}
+
+// decode decodes the JSON request body into the provided value, limiting the body size.
+func (h *Handler) decode(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.opts.MaxBodyBytes))
+	if err != nil {
+		return fmt.Errorf("failure to read request body: %w", err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failure to decode request body: %w", err)
+	}
+
+	return nil
+}
+
+// writeJSON writes the value encoded via shared.ToJSON with the provided status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := shared.ToJSON(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeError writes the error response with the provided status code.
+func writeError(w http.ResponseWriter, status int, err error) {
+	data, _ := shared.ToJSON(ErrorResponse{Error: err.Error()})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
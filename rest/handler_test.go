@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/shared"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	if !standards.StandardsLoaded() {
+		assert.NoError(t, standards.LoadStandards())
+	}
+
+	h, err := NewHandler(Options{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return h
+}
+
+func serve(h http.Handler, method string, path string, body interface{}) *httptest.ResponseRecorder {
+	var reader bytes.Buffer
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader.Write(data)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, path, &reader))
+	return rec
+}
+
+func TestHandlerStandards(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := serve(h, http.MethodGet, "/standards", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var list []shared.ContractStandard
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Len(t, list, len(standards.GetRegisteredStandards()))
+
+	eip, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+	expected, err := shared.ToJSON(eip.GetStandard())
+	assert.NoError(t, err)
+
+	rec = serve(h, http.MethodGet, "/standards/erc20", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, string(expected), rec.Body.String())
+
+	rec = serve(h, http.MethodGet, "/standards/ERC0", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "standard not found: ERC0")
+
+	rec = serve(h, http.MethodPost, "/standards", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandlerDetect(t *testing.T) {
+	h := newTestHandler(t)
+
+	eip, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+	code, err := os.ReadFile("testdata/token.hex")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		path       string
+		body       interface{}
+		code       int
+		expected   shared.Standard
+		confidence shared.ConfidenceLevel
+		error      string
+	}{
+		{
+			name:       "ABI",
+			path:       "/detect/abi",
+			body:       DetectABIRequest{Name: "Token", ABI: json.RawMessage(eip.GetABI())},
+			code:       http.StatusOK,
+			expected:   standards.ERC20,
+			confidence: shared.PerfectConfidence,
+		},
+		{
+			// Bytecode carries no state mutability, so strict matching cannot discover the mutability tokens.
+			name:       "Bytecode",
+			path:       "/detect/bytecode",
+			body:       DetectBytecodeRequest{Name: "Token", Bytecode: string(code), Strict: true},
+			code:       http.StatusOK,
+			expected:   standards.ERC20,
+			confidence: shared.HighConfidence,
+		},
+		{name: "Missing ABI", path: "/detect/abi", body: DetectABIRequest{Name: "Token"}, code: http.StatusBadRequest, error: "abi is required"},
+		{name: "Invalid ABI", path: "/detect/abi", body: map[string]interface{}{"abi": "{"}, code: http.StatusBadRequest},
+		{name: "Invalid Bytecode", path: "/detect/bytecode", body: DetectBytecodeRequest{Bytecode: "0xzz"}, code: http.StatusBadRequest},
+		{name: "Missing Bytecode", path: "/detect/bytecode", body: DetectBytecodeRequest{}, code: http.StatusBadRequest, error: "bytecode is required"},
+		{name: "Invalid Body", path: "/detect/abi", body: "[", code: http.StatusBadRequest, error: "failure to decode request body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(h, http.MethodPost, tt.path, tt.body)
+			assert.Equal(t, tt.code, rec.Code, rec.Body.String())
+
+			if tt.code != http.StatusOK {
+				var resp ErrorResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Contains(t, resp.Error, tt.error)
+				return
+			}
+
+			var resp DetectResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "Token", resp.Contract)
+			if assert.NotEmpty(t, resp.Discoveries) {
+				assert.Equal(t, tt.expected, resp.Discoveries[0].Standard)
+				assert.Equal(t, tt.confidence, resp.Discoveries[0].Confidence)
+			}
+		})
+	}
+}
+
+func TestHandlerMaxBodyBytes(t *testing.T) {
+	newTestHandler(t)
+
+	h, err := NewHandler(Options{MaxBodyBytes: 16})
+	assert.NoError(t, err)
+
+	rec := serve(h, http.MethodPost, "/detect/bytecode", DetectBytecodeRequest{Bytecode: "0x6080604052348015600f57600080fd5b50"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "failure to read request body")
+}
+
+func TestMount(t *testing.T) {
+	h := newTestHandler(t)
+
+	tests := []struct {
+		name   string
+		prefix string
+		path   string
+	}{
+		{name: "Prefix", prefix: "/api/standards", path: "/api/standards/standards/ERC20"},
+		{name: "Trailing Slash", prefix: "/api/", path: "/api/standards/ERC20"},
+		{name: "Root", prefix: "", path: "/standards/ERC20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {})
+			Mount(mux, tt.prefix, h)
+
+			assert.Equal(t, http.StatusOK, serve(mux, http.MethodGet, tt.path, nil).Code)
+			assert.Equal(t, http.StatusOK, serve(mux, http.MethodGet, "/health", nil).Code)
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := serve(h, http.MethodGet, "/openapi.json", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var doc struct {
+		OpenAPI string                            `json:"openapi"`
+		Paths   map[string]map[string]interface{} `json:"paths"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+
+	// Every served route must be described by the document.
+	routes := map[string]string{
+		"/standards":            "get",
+		"/standards/{standard}": "get",
+		"/detect/abi":           "post",
+		"/detect/bytecode":      "post",
+		"/openapi.json":         "get",
+	}
+	assert.Len(t, doc.Paths, len(routes))
+	for path, method := range routes {
+		assert.Contains(t, doc.Paths[path], method, path)
+	}
+}
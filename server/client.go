@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Client is a client of the standards gRPC service.
+type Client struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewClient creates a new Client on top of the provided client connection.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{cc: cc}
+}
+
+// ListStandards returns all standards registered within the server, ordered by their type.
+func (c *Client) ListStandards(ctx context.Context, opts ...grpc.CallOption) (*eip_pb.Standards, error) {
+	out := new(eip_pb.Standards)
+	if err := c.cc.Invoke(ctx, ListStandardsMethod, &emptypb.Empty{}, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetStandard returns a standard registered within the server by its type, e.g. "ERC20".
+func (c *Client) GetStandard(ctx context.Context, name string, opts ...grpc.CallOption) (*eip_pb.ContractStandard, error) {
+	out := new(eip_pb.ContractStandard)
+	if err := c.cc.Invoke(ctx, GetStandardMethod, wrapperspb.String(name), out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DetectContract returns discoveries of standards implemented by the contract, ordered by confidence points
+// in descending order, once the server has streamed all of them.
+func (c *Client) DetectContract(ctx context.Context, contract *eip_pb.Contract, opts ...grpc.CallOption) ([]*eip_pb.Discovery, error) {
+	stream, err := c.cc.NewStream(ctx, &ServiceDesc.Streams[0], DetectContractMethod, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(contract); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	toReturn := make([]*eip_pb.Discovery, 0)
+	for {
+		discovery := new(eip_pb.Discovery)
+		if err := stream.RecvMsg(discovery); err != nil {
+			if err == io.EOF {
+				return toReturn, nil
+			}
+			return nil, err
+		}
+		toReturn = append(toReturn, discovery)
+	}
+}
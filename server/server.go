@@ -0,0 +1,94 @@
+// Package server implements the standards gRPC service, exposing the registry of Ethereum standards and
+// the standard detection over the github.com/unpackdev/protos eip messages, so that services written in other
+// languages can share a single detection engine. The service is defined in standards.proto.
+//
+// Standards missing from the eip_pb.Standard enum are reported with the UNKNOWN type.
+package server
+
+import (
+	"context"
+	"strings"
+
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Server implements the standards gRPC service on top of the registered standards.
+type Server struct {
+	detector *standards.Detector
+}
+
+// NewServer creates a new Server out of the currently registered standards, detecting contracts with the
+// provided options. It returns an error if no standards are registered, see standards.LoadStandards.
+func NewServer(opts standards.DetectorOptions) (*Server, error) {
+	detector, err := standards.NewDetector(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{detector: detector}, nil
+}
+
+// Register registers the server within the provided gRPC server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	RegisterStandardsServer(registrar, s)
+}
+
+// ListStandards returns all registered standards, ordered by their type.
+func (s *Server) ListStandards(ctx context.Context, req *emptypb.Empty) (*eip_pb.Standards, error) {
+	eips := standards.GetSortedRegisteredStandards()
+
+	toReturn := &eip_pb.Standards{Standards: make([]*eip_pb.ContractStandard, 0, len(eips))}
+	for _, eip := range eips {
+		toReturn.Standards = append(toReturn.Standards, eip.ToProto())
+	}
+
+	return toReturn, nil
+}
+
+// GetStandard returns a registered standard by its case-insensitive type, e.g. "ERC20".
+// It fails with codes.NotFound if the standard is not registered.
+func (s *Server) GetStandard(ctx context.Context, req *wrapperspb.StringValue) (*eip_pb.ContractStandard, error) {
+	name := strings.ToUpper(strings.TrimSpace(req.GetValue()))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "standard name is required")
+	}
+
+	eip, found := standards.GetStandard(shared.Standard(name))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", errors.ErrStandardNotFound, name)
+	}
+
+	return eip.ToProto(), nil
+}
+
+// DetectContract streams discoveries of standards implemented by the contract, ordered by confidence points
+// in descending order. It fails with codes.InvalidArgument if the contract has neither functions nor events.
+func (s *Server) DetectContract(req *eip_pb.Contract, stream DetectContractServer) error {
+	if len(req.GetFunctions()) == 0 && len(req.GetEvents()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "%s: contract has neither functions nor events", errors.ErrInvalidContract)
+	}
+
+	discoveries, err := s.detector.Detect(shared.NewContractMatcherFromProto(req))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for _, discovery := range discoveries {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if err := stream.Send(discovery.ToProto()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/server/servertest"
+	"github.com/unpackdev/standards/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func startServer(t *testing.T) *servertest.Server {
+	if !standards.StandardsLoaded() {
+		assert.NoError(t, standards.LoadStandards())
+	}
+
+	srv, err := servertest.New(standards.DetectorOptions{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Close())
+	})
+
+	return srv
+}
+
+func TestListStandards(t *testing.T) {
+	srv := startServer(t)
+
+	resp, err := srv.Client.ListStandards(context.Background())
+	assert.NoError(t, err)
+
+	eips := standards.GetSortedRegisteredStandards()
+	if !assert.Len(t, resp.GetStandards(), len(eips)) {
+		return
+	}
+	for i, eip := range eips {
+		assert.Equal(t, eip.GetName(), resp.GetStandards()[i].GetName())
+	}
+}
+
+func TestGetStandard(t *testing.T) {
+	srv := startServer(t)
+
+	tests := []struct {
+		name     string
+		standard string
+		expected eip_pb.Standard
+		code     codes.Code
+	}{
+		{name: "ERC20", standard: "ERC20", expected: eip_pb.Standard_ERC20, code: codes.OK},
+		{name: "Lower Case", standard: "erc721", expected: eip_pb.Standard_ERC721, code: codes.OK},
+		{name: "Unknown", standard: "ERC0", code: codes.NotFound},
+		{name: "Empty", standard: "", code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := srv.Client.GetStandard(context.Background(), tt.standard)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				assert.Equal(t, tt.expected, resp.GetType())
+				assert.NotEmpty(t, resp.GetFunctions())
+			}
+		})
+	}
+}
+
+func TestDetectContract(t *testing.T) {
+	srv := startServer(t)
+
+	eip, err := standards.GetContractByStandard(standards.ERC20)
+	assert.NoError(t, err)
+	contract := &shared.ContractMatcher{Name: "Token", Functions: eip.GetFunctions(), Events: eip.GetEvents()}
+
+	discoveries, err := srv.Client.DetectContract(context.Background(), contract.ToProto())
+	assert.NoError(t, err)
+	if !assert.NotEmpty(t, discoveries) {
+		return
+	}
+	assert.Equal(t, eip_pb.Standard_ERC20, discoveries[0].GetStandard())
+	assert.Equal(t, int32(100), discoveries[0].GetConfidencePoints())
+	assert.NotNil(t, discoveries[0].GetContract())
+
+	for i := 1; i < len(discoveries); i++ {
+		assert.GreaterOrEqual(t, discoveries[i-1].GetConfidencePoints(), discoveries[i].GetConfidencePoints())
+	}
+
+	_, err = srv.Client.DetectContract(context.Background(), &eip_pb.Contract{Name: "Empty"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,71 @@
+// Package servertest provides an in-process standards gRPC server for tests, served over an in-memory
+// connection instead of the network.
+package servertest
+
+import (
+	"context"
+	"net"
+
+	"github.com/unpackdev/standards"
+	"github.com/unpackdev/standards/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufferSize is the size of the in-memory connection buffer.
+const bufferSize = 1024 * 1024
+
+// Server represents an in-process standards gRPC server along with a client connected to it.
+type Server struct {
+	// Conn is the client connection to the server, usable by any client of the service.
+	Conn *grpc.ClientConn
+
+	// Client is the standards client connected to the server.
+	Client *server.Client
+
+	grpcServer *grpc.Server
+	listener   *bufconn.Listener
+}
+
+// New starts an in-process standards gRPC server with the provided detector options and connects
+// a client to it. Standards need to be registered beforehand, see standards.LoadStandards.
+// The server must be stopped with Close once no longer needed.
+func New(opts standards.DetectorOptions, serverOpts ...grpc.ServerOption) (*Server, error) {
+	srv, err := server.NewServer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := bufconn.Listen(bufferSize)
+	grpcServer := grpc.NewServer(serverOpts...)
+	srv.Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener) // Serve only returns once the server is stopped...
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, err
+	}
+
+	return &Server{
+		Conn:       conn,
+		Client:     server.NewClient(conn),
+		grpcServer: grpcServer,
+		listener:   listener,
+	}, nil
+}
+
+// Close closes the client connection and stops the server.
+func (s *Server) Close() error {
+	err := s.Conn.Close()
+	s.grpcServer.Stop()
+	return err
+}
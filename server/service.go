@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ServiceName is the fully qualified name of the standards gRPC service, as defined in standards.proto.
+const ServiceName = "unpack.v1.standards.StandardsService"
+
+// Full method names of the standards gRPC service.
+const (
+	ListStandardsMethod  = "/" + ServiceName + "/ListStandards"
+	GetStandardMethod    = "/" + ServiceName + "/GetStandard"
+	DetectContractMethod = "/" + ServiceName + "/DetectContract"
+)
+
+// StandardsServer is the server API of the standards gRPC service.
+type StandardsServer interface {
+	// ListStandards returns all registered standards, ordered by their type.
+	ListStandards(ctx context.Context, req *emptypb.Empty) (*eip_pb.Standards, error)
+
+	// GetStandard returns a registered standard by its type, e.g. "ERC20".
+	GetStandard(ctx context.Context, req *wrapperspb.StringValue) (*eip_pb.ContractStandard, error)
+
+	// DetectContract streams discoveries of standards implemented by the contract.
+	DetectContract(req *eip_pb.Contract, stream DetectContractServer) error
+}
+
+// DetectContractServer is the server side stream of the DetectContract method.
+type DetectContractServer interface {
+	Send(*eip_pb.Discovery) error
+	grpc.ServerStream
+}
+
+// ServiceDesc is the grpc.ServiceDesc of the standards gRPC service. It is hand written, equivalent to
+// the one generated by protoc-gen-go-grpc out of standards.proto, as all messages come from the eip protos.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*StandardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "ListStandards", Handler: listStandardsHandler},
+		{MethodName: "GetStandard", Handler: getStandardHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "DetectContract", Handler: detectContractHandler, ServerStreams: true},
+	},
+	Metadata: "standards.proto",
+}
+
+// RegisterStandardsServer registers the implementation of the standards gRPC service within the registrar.
+func RegisterStandardsServer(registrar grpc.ServiceRegistrar, srv StandardsServer) {
+	registrar.RegisterService(&ServiceDesc, srv)
+}
+
+func listStandardsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandardsServer).ListStandards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: ListStandardsMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandardsServer).ListStandards(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func getStandardHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandardsServer).GetStandard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: GetStandardMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandardsServer).GetStandard(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func detectContractHandler(srv interface{}, stream grpc.ServerStream) error {
+	in := new(eip_pb.Contract)
+	if err := stream.RecvMsg(in); err != nil {
+		return err
+	}
+	return srv.(StandardsServer).DetectContract(in, &detectContractServer{stream})
+}
+
+// detectContractServer implements DetectContractServer on top of the generic server stream.
+type detectContractServer struct {
+	grpc.ServerStream
+}
+
+// Send sends the discovery to the client.
+func (x *detectContractServer) Send(m *eip_pb.Discovery) error {
+	return x.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,157 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+)
+
+// ABIParameter represents a single input or output parameter of a JSON contract ABI entry.
+type ABIParameter struct {
+	Name         string         `json:"name"`
+	Type         string         `json:"type"`
+	InternalType string         `json:"internalType,omitempty"`
+	Indexed      bool           `json:"indexed,omitempty"`
+	Components   []ABIParameter `json:"components,omitempty"`
+}
+
+// ABIEntry represents a single entry (function, event, error, constructor...) of a JSON contract ABI.
+type ABIEntry struct {
+	Type            string         `json:"type"`
+	Name            string         `json:"name"`
+	Inputs          []ABIParameter `json:"inputs"`
+	Outputs         []ABIParameter `json:"outputs"`
+	StateMutability string         `json:"stateMutability,omitempty"`
+	Constant        bool           `json:"constant,omitempty"`
+	Payable         bool           `json:"payable,omitempty"`
+	Anonymous       bool           `json:"anonymous,omitempty"`
+}
+
+// GetStateMutability returns the state mutability of the ABI entry. Legacy ABIs (pre solidity 0.4.16)
+// that only define `constant` and `payable` flags are resolved to their modern equivalents.
+func (e *ABIEntry) GetStateMutability() StateMutability {
+	switch {
+	case e.StateMutability != "":
+		return StateMutability(e.StateMutability)
+	case e.Constant:
+		return StateMutabilityView
+	case e.Payable:
+		return StateMutabilityPayable
+	default:
+		return StateMutabilityNonPayable
+	}
+}
+
+// ParseABI parses the provided JSON contract ABI into a slice of ABI entries.
+func ParseABI(abi []byte) ([]ABIEntry, error) {
+	var entries []ABIEntry
+	if err := json.Unmarshal(abi, &entries); err != nil {
+		return nil, fmt.Errorf("failure to parse abi: %w", err)
+	}
+	return entries, nil
+}
+
+// NewContractMatcherFromABI creates a new ContractMatcher out of the provided JSON contract ABI.
+// Functions, events and errors are extracted, while constructors, fallback and receive entries are ignored.
+func NewContractMatcherFromABI(name string, abi []byte) (*ContractMatcher, error) {
+	entries, err := ParseABI(abi)
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := &ContractMatcher{
+		Name:      name,
+		Functions: make([]Function, 0),
+		Events:    make([]Event, 0),
+		Errors:    make([]Error, 0),
+	}
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case "function":
+			fn := NewFunction(entry.Name, abiInputs(entry.Inputs), abiOutputs(entry.Outputs))
+			fn.StateMutability = entry.GetStateMutability()
+			toReturn.Functions = append(toReturn.Functions, fn)
+		case "event":
+			toReturn.Events = append(toReturn.Events, NewEvent(entry.Name, abiInputs(entry.Inputs), nil))
+		case "error":
+			toReturn.Errors = append(toReturn.Errors, NewError(entry.Name, abiInputs(entry.Inputs)))
+		}
+	}
+
+	return toReturn, nil
+}
+
+// ResolveStateMutability populates the state mutability of the standard functions out of the standard ABI.
+// Functions are resolved by their canonical signature so overloaded functions get their own mutability.
+// Functions that are not present in the ABI are left untouched.
+func (cs *ContractStandard) ResolveStateMutability() error {
+	if cs.ABI == "" {
+		return nil
+	}
+
+	matcher, err := NewContractMatcherFromABI(cs.Name, []byte(cs.ABI))
+	if err != nil {
+		return fmt.Errorf("standard %s: %w", cs.Type, err)
+	}
+
+	mutability := make(map[string]StateMutability, len(matcher.Functions))
+	for _, fn := range matcher.Functions {
+		mutability[fn.Signature()] = fn.StateMutability
+	}
+
+	for i := range cs.Functions {
+		if m, ok := mutability[cs.Functions[i].Signature()]; ok {
+			cs.Functions[i].StateMutability = m
+		}
+	}
+
+	return nil
+}
+
+// abiInputs converts ABI parameters into a slice of Input structs, including nested tuple components.
+func abiInputs(params []ABIParameter) []Input {
+	if len(params) == 0 {
+		return nil
+	}
+
+	toReturn := make([]Input, 0, len(params))
+	for _, param := range params {
+		toReturn = append(toReturn, Input{
+			Type:       param.Type,
+			Indexed:    param.Indexed,
+			Components: abiComponents(param.Components),
+		})
+	}
+	return toReturn
+}
+
+// abiComponents converts ABI tuple components into a slice of named Input structs.
+func abiComponents(params []ABIParameter) []Input {
+	if len(params) == 0 {
+		return nil
+	}
+
+	toReturn := make([]Input, 0, len(params))
+	for _, param := range params {
+		toReturn = append(toReturn, Input{
+			Name:       param.Name,
+			Type:       param.Type,
+			Components: abiComponents(param.Components),
+		})
+	}
+	return toReturn
+}
+
+// abiOutputs converts ABI parameters into a slice of Output structs.
+func abiOutputs(params []ABIParameter) []Output {
+	if len(params) == 0 {
+		return nil
+	}
+
+	toReturn := make([]Output, 0, len(params))
+	for _, param := range params {
+		toReturn = append(toReturn, Output{Type: param.Type, Components: abiComponents(param.Components)})
+	}
+	return toReturn
+}
@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContractMatcherFromABI(t *testing.T) {
+	abi := `[
+		{"constant":true,"inputs":[{"name":"_owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"balance","type":"uint256"}],"payable":false,"type":"function"},
+		{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"payable","type":"function"},
+		{"constant":false,"inputs":[],"name":"legacy","outputs":[],"payable":false,"type":"function"},
+		{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},
+		{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"OwnableUnauthorizedAccount","type":"error"},
+		{"payable":true,"stateMutability":"payable","type":"fallback"}
+	]`
+
+	matcher, err := NewContractMatcherFromABI("Token", []byte(abi))
+	assert.NoError(t, err)
+	assert.Equal(t, "Token", matcher.Name)
+	assert.Len(t, matcher.Functions, 3)
+	assert.Len(t, matcher.Events, 1)
+	assert.Len(t, matcher.Errors, 1)
+
+	assert.Equal(t, StateMutabilityView, matcher.Functions[0].StateMutability)
+	assert.Equal(t, StateMutabilityPayable, matcher.Functions[1].StateMutability)
+	assert.Equal(t, StateMutabilityNonPayable, matcher.Functions[2].StateMutability)
+	assert.True(t, matcher.Events[0].Inputs[0].Indexed)
+	assert.False(t, matcher.Events[0].Inputs[2].Indexed)
+	assert.Equal(t, "OwnableUnauthorizedAccount(address)", matcher.Errors[0].Signature())
+
+	_, err = NewContractMatcherFromABI("Broken", []byte(`{"type":`))
+	assert.Error(t, err)
+}
+
+func TestStateMutabilityAllows(t *testing.T) {
+	assert.True(t, StateMutabilityView.Allows(StateMutabilityView))
+	assert.True(t, StateMutabilityView.Allows(StateMutabilityPure))
+	assert.True(t, StateMutabilityNonPayable.Allows(StateMutabilityView))
+	assert.True(t, StateMutabilityView.Allows(""))
+	assert.False(t, StateMutabilityView.Allows(StateMutabilityNonPayable))
+	assert.False(t, StateMutabilityNonPayable.Allows(StateMutabilityPayable))
+	assert.False(t, StateMutabilityPayable.Allows(StateMutabilityNonPayable))
+}
+
+func TestNewContractMatcherFromProto(t *testing.T) {
+	abi := `[
+		{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+		{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}
+	]`
+
+	matcher, err := NewContractMatcherFromABI("Token", []byte(abi))
+	assert.NoError(t, err)
+
+	decoded := NewContractMatcherFromProto(matcher.ToProto())
+	assert.Equal(t, "Token", decoded.Name)
+	assert.Len(t, decoded.Functions, 1)
+	assert.Len(t, decoded.Events, 1)
+	assert.Equal(t, matcher.Functions[0].Selector(), decoded.Functions[0].Selector())
+	assert.Equal(t, "bool", decoded.Functions[0].Outputs[0].Type)
+	assert.Equal(t, matcher.Events[0].Topic(), decoded.Events[0].Topic())
+	assert.True(t, decoded.Events[0].Inputs[0].Indexed)
+	assert.Empty(t, decoded.Functions[0].StateMutability)
+
+	assert.Empty(t, NewContractMatcherFromProto(nil).Functions)
+}
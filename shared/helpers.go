@@ -27,6 +27,14 @@ func NewEvent(name string, inputs []Input, outputs []Output) Event {
 	}
 }
 
+// NewError creates and returns a new Error struct with the provided name and inputs.
+func NewError(name string, inputs []Input) Error {
+	return Error{
+		Name:   name,
+		Inputs: inputs,
+	}
+}
+
 // GetProtoStandardFromString converts a string representation of an Ethereum standard
 // to its corresponding protobuf enum value. If the standard is not recognized,
 // it returns an error.
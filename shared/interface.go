@@ -27,6 +27,10 @@ type EIP interface {
 	// events defined in the Ethereum standard.
 	GetEvents() []Event
 
+	// GetErrors returns a slice of Error structs, representing the
+	// custom errors defined in the Ethereum standard.
+	GetErrors() []Error
+
 	// GetStandard returns the complete representation of the Ethereum standard.
 	GetStandard() ContractStandard
 
@@ -0,0 +1,86 @@
+package shared
+
+import "strings"
+
+// TypeAliases maps user-defined value types and other type aliases to the ABI types they resolve to, e.g.
+// TypeAliases{"Currency": "address"} for Uniswap V4 sources. Aliases are scoped to the input they are declared
+// by, such as a parsed Solidity source, so that equally named types of unrelated projects do not collide.
+type TypeAliases map[string]string
+
+// Normalize returns the canonical ABI form of the provided type, see NormalizeType, additionally resolving
+// the aliases to their underlying types.
+func (a TypeAliases) Normalize(typ string) string {
+	return normalizeType(typ, a)
+}
+
+// NormalizeType returns the canonical ABI form of the provided Solidity type, so that equivalent types
+// compare equal. It removes data locations, resolves `uint`/`int`/`byte`/`fixed` shorthands, strips
+// `payable` from addresses and resolves contract and interface types to addresses and enums to uint8.
+// Array suffixes are preserved. User-defined value types are resolved by TypeAliases.Normalize.
+func NormalizeType(typ string) string {
+	return normalizeType(typ, nil)
+}
+
+// normalizeType implements NormalizeType, resolving the provided aliases.
+func normalizeType(typ string, aliases TypeAliases) string {
+	typ = strings.TrimSpace(typ)
+	if typ == "" {
+		return typ
+	}
+
+	base, suffix := typ, ""
+	if idx := strings.IndexByte(typ, '['); idx >= 0 {
+		base, suffix = strings.TrimSpace(typ[:idx]), typ[idx:]
+		if strings.IndexByte(suffix, ' ') >= 0 {
+			suffix = strings.ReplaceAll(suffix, " ", "")
+		}
+	}
+
+	// Types coming out of the ABI have no modifiers, so fields are only split when there is something to strip.
+	if strings.ContainsAny(base, " \t\n") {
+		fields := strings.Fields(base)
+		for len(fields) > 1 {
+			switch fields[len(fields)-1] {
+			case "memory", "calldata", "storage", "payable":
+				fields = fields[:len(fields)-1]
+				continue
+			}
+			break
+		}
+
+		if len(fields) == 2 {
+			switch fields[0] {
+			case "contract", "interface":
+				return TypeAddress + suffix
+			case "enum":
+				return TypeUint8 + suffix
+			case "struct":
+				return TypeTuple + suffix
+			}
+		}
+
+		base = strings.Join(fields, " ")
+	}
+
+	switch base {
+	case "uint":
+		return TypeUint256 + suffix
+	case "int":
+		return TypeInt256 + suffix
+	case "byte":
+		return "bytes1" + suffix
+	case "fixed":
+		return "fixed128x18" + suffix
+	case "ufixed":
+		return "ufixed128x18" + suffix
+	}
+
+	if alias, ok := aliases[base]; ok {
+		return NormalizeType(alias) + suffix
+	}
+
+	if len(base)+len(suffix) == len(typ) {
+		return typ
+	}
+	return base + suffix
+}
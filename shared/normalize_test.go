@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeType(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected string
+	}{
+		{typ: "uint", expected: "uint256"},
+		{typ: "int", expected: "int256"},
+		{typ: "uint[]", expected: "uint256[]"},
+		{typ: "uint[ 3 ]", expected: "uint256[3]"},
+		{typ: "byte", expected: "bytes1"},
+		{typ: "fixed", expected: "fixed128x18"},
+		{typ: "ufixed[]", expected: "ufixed128x18[]"},
+		{typ: "address payable", expected: "address"},
+		{typ: "address payable[]", expected: "address[]"},
+		{typ: "contract IERC20", expected: "address"},
+		{typ: "interface IERC721[]", expected: "address[]"},
+		{typ: "enum Status", expected: "uint8"},
+		{typ: "struct PoolKey", expected: "tuple"},
+		{typ: "struct PoolKey[]", expected: "tuple[]"},
+		{typ: "string memory", expected: "string"},
+		{typ: "bytes calldata", expected: "bytes"},
+		{typ: "Currency", expected: "Currency"},
+		{typ: " uint256 ", expected: "uint256"},
+		{typ: "bytes32", expected: "bytes32"},
+		{typ: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeType(tt.typ))
+		})
+	}
+
+}
+
+func TestTypeAliasesNormalize(t *testing.T) {
+	aliases := TypeAliases{"Currency": TypeAddress, "BalanceDelta": TypeInt256, "Price": "uint"}
+	assert.Equal(t, "address", aliases.Normalize("Currency"))
+	assert.Equal(t, "int256[2]", aliases.Normalize("BalanceDelta[2]"))
+	assert.Equal(t, "uint256[]", aliases.Normalize("Price[]"))
+	assert.Equal(t, "address", aliases.Normalize("contract IHooks"))
+
+	// Aliases are scoped to their input and never leak into other normalizations.
+	assert.Equal(t, "Price", NormalizeType("Price"))
+	assert.Equal(t, "Price", TypeAliases(nil).Normalize("Price"))
+}
+
+func TestNormalizedSignatures(t *testing.T) {
+	transfer := NewFunction("transfer", []Input{{Type: "address payable"}, {Type: "uint"}}, []Output{{Type: TypeBool}})
+	assert.Equal(t, "transfer(address,uint256)", transfer.Signature())
+	assert.Equal(t, "0xa9059cbb", transfer.Selector())
+
+	hook := Input{Type: "struct PoolKey", Components: []Input{
+		{Name: "currency0", Type: "address payable"},
+		{Name: "currency1", Type: "address payable"},
+		{Name: "fee", Type: TypeUint24},
+		{Name: "tickSpacing", Type: TypeInt24},
+		{Name: "hooks", Type: "contract IHooks"},
+	}}
+	assert.Equal(t, "(address,address,uint24,int24,address)", hook.CanonicalType())
+}
@@ -0,0 +1,174 @@
+package shared
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Keccak256 calculates and returns the Keccak-256 hash of the provided data.
+func Keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
+
+// Signature returns the canonical signature of the function, e.g. "transfer(address,uint256)".
+func (f *Function) Signature() string {
+	return canonicalSignature(f.Name, f.Inputs)
+}
+
+// Selector returns the hex encoded 4-byte selector of the function, e.g. "0xa9059cbb".
+func (f *Function) Selector() string {
+	return selector(f.Signature())
+}
+
+// Signature returns the canonical signature of the event, e.g. "Transfer(address,address,uint256)".
+func (e *Event) Signature() string {
+	return canonicalSignature(e.Name, e.Inputs)
+}
+
+// Topic returns the hex encoded 32-byte topic hash of the event as it appears in topic0 of emitted logs.
+func (e *Event) Topic() string {
+	return "0x" + hex.EncodeToString(Keccak256([]byte(e.Signature())))
+}
+
+// Signature returns the canonical signature of the error, e.g. "OwnableUnauthorizedAccount(address)".
+func (e *Error) Signature() string {
+	return canonicalSignature(e.Name, e.Inputs)
+}
+
+// Selector returns the hex encoded 4-byte selector of the error, e.g. "0x118cdaa7".
+func (e *Error) Selector() string {
+	return selector(e.Signature())
+}
+
+// InterfaceID returns the hex encoded ERC-165 interface identifier of the provided functions, that is the
+// XOR of all function selectors, e.g. "0x01ffc9a7" for supportsInterface(bytes4).
+func InterfaceID(functions []Function) string {
+	id := make([]byte, 4)
+	for _, fn := range functions {
+		hash := Keccak256([]byte(fn.Signature()))
+		for i := range id {
+			id[i] ^= hash[i]
+		}
+	}
+	return "0x" + hex.EncodeToString(id)
+}
+
+// CanonicalType returns the canonical ABI representation of the provided type. Types are normalized
+// (see NormalizeType) and tuple types, including arrays of tuples, are expanded into their (recursively
+// canonical) component types, so that "tuple[]" with address and uint components becomes "(address,uint256)[]".
+func CanonicalType(typ string, components []Input) string {
+	typ = NormalizeType(typ)
+	if !strings.HasPrefix(typ, TypeTuple) {
+		return typ
+	}
+	return "(" + canonicalTypes(components) + ")" + strings.TrimPrefix(typ, TypeTuple)
+}
+
+// canonicalTypes returns comma separated canonical types of the provided inputs.
+func canonicalTypes(inputs []Input) string {
+	types := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		types = append(types, input.CanonicalType())
+	}
+	return strings.Join(types, ",")
+}
+
+// canonicalSignature builds the canonical signature out of the member name and its input types.
+func canonicalSignature(name string, inputs []Input) string {
+	return name + "(" + canonicalTypes(inputs) + ")"
+}
+
+// selector returns the hex encoded first 4 bytes of the Keccak-256 hash of the provided signature.
+func selector(signature string) string {
+	return "0x" + hex.EncodeToString(Keccak256([]byte(signature))[:4])
+}
+
+// NormalizeSignature returns the canonical form of a hand written signature, so that
+// "Transfer(address indexed from, address to, uint value)" becomes "Transfer(address,address,uint256)".
+// Parameter names, data locations and the indexed keyword are removed and types are normalized, see NormalizeType.
+// Tuple parameters are expected in their expanded form, e.g. "(address,uint256)[] calls".
+func NormalizeSignature(signature string) string {
+	signature = strings.TrimSpace(signature)
+	open := strings.IndexByte(signature, '(')
+	if open < 0 || !strings.HasSuffix(signature, ")") {
+		return signature
+	}
+	return strings.TrimSpace(signature[:open]) + "(" + normalizeParams(signature[open+1:len(signature)-1]) + ")"
+}
+
+// SignatureSelector returns the hex encoded 4-byte selector of the function or error signature, see NormalizeSignature.
+func SignatureSelector(signature string) string {
+	return selector(NormalizeSignature(signature))
+}
+
+// SignatureTopic returns the hex encoded 32-byte topic hash of the event signature, see NormalizeSignature.
+func SignatureTopic(signature string) string {
+	return "0x" + hex.EncodeToString(Keccak256([]byte(NormalizeSignature(signature))))
+}
+
+// normalizeParams normalizes a comma separated parameter list, splitting it only at commas outside of tuples.
+func normalizeParams(params string) string {
+	if strings.TrimSpace(params) == "" {
+		return ""
+	}
+
+	toReturn := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range params {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				toReturn = append(toReturn, normalizeParam(params[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	toReturn = append(toReturn, normalizeParam(params[start:]))
+	return strings.Join(toReturn, ",")
+}
+
+// normalizeParam normalizes a single parameter, dropping its name and modifiers.
+func normalizeParam(param string) string {
+	param = strings.TrimSpace(param)
+
+	if strings.HasPrefix(param, "(") {
+		depth := 0
+		for i, c := range param {
+			switch c {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 {
+				suffix := strings.TrimSpace(param[i+1:])
+				if idx := strings.IndexAny(suffix, " \t\n"); idx >= 0 {
+					suffix = suffix[:idx]
+				}
+				return "(" + normalizeParams(param[1:i]) + ")" + suffix
+			}
+		}
+		return param
+	}
+
+	fields := make([]string, 0, 3)
+	for _, field := range strings.Fields(param) {
+		switch field {
+		case "indexed", "memory", "calldata", "storage":
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) > 1 && fields[len(fields)-1] != "payable" {
+		fields = fields[:len(fields)-1]
+	}
+	return NormalizeType(strings.Join(fields, " "))
+}
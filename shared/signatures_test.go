@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatures(t *testing.T) {
+	transfer := NewFunction("transfer", []Input{{Type: TypeAddress}, {Type: TypeUint256}}, []Output{{Type: TypeBool}})
+	assert.Equal(t, "transfer(address,uint256)", transfer.Signature())
+	assert.Equal(t, "0xa9059cbb", transfer.Selector())
+
+	totalSupply := NewFunction("totalSupply", nil, []Output{{Type: TypeUint256}})
+	assert.Equal(t, "totalSupply()", totalSupply.Signature())
+	assert.Equal(t, "0x18160ddd", totalSupply.Selector())
+
+	event := NewEvent("Transfer", []Input{{Type: TypeAddress, Indexed: true}, {Type: TypeAddress, Indexed: true}, {Type: TypeUint256}}, nil)
+	assert.Equal(t, "Transfer(address,address,uint256)", event.Signature())
+	assert.Equal(t, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", event.Topic())
+
+	unauthorized := NewError("OwnableUnauthorizedAccount", []Input{{Type: TypeAddress}})
+	assert.Equal(t, "OwnableUnauthorizedAccount(address)", unauthorized.Signature())
+	assert.Equal(t, "0x118cdaa7", unauthorized.Selector())
+}
+
+func TestInterfaceID(t *testing.T) {
+	supportsInterface := NewFunction("supportsInterface", []Input{{Type: TypeBytes4}}, []Output{{Type: TypeBool}})
+	assert.Equal(t, "0x01ffc9a7", InterfaceID([]Function{supportsInterface}))
+
+	erc721 := []Function{
+		NewFunction("balanceOf", []Input{{Type: TypeAddress}}, nil),
+		NewFunction("ownerOf", []Input{{Type: TypeUint256}}, nil),
+		NewFunction("safeTransferFrom", []Input{{Type: TypeAddress}, {Type: TypeAddress}, {Type: TypeUint256}, {Type: TypeBytes}}, nil),
+		NewFunction("safeTransferFrom", []Input{{Type: TypeAddress}, {Type: TypeAddress}, {Type: TypeUint256}}, nil),
+		NewFunction("transferFrom", []Input{{Type: TypeAddress}, {Type: TypeAddress}, {Type: TypeUint256}}, nil),
+		NewFunction("approve", []Input{{Type: TypeAddress}, {Type: TypeUint256}}, nil),
+		NewFunction("setApprovalForAll", []Input{{Type: TypeAddress}, {Type: TypeBool}}, nil),
+		NewFunction("getApproved", []Input{{Type: TypeUint256}}, nil),
+		NewFunction("isApprovedForAll", []Input{{Type: TypeAddress}, {Type: TypeAddress}}, nil),
+	}
+	assert.Equal(t, "0x80ac58cd", InterfaceID(erc721))
+	assert.Equal(t, "0x00000000", InterfaceID(nil))
+}
+
+func TestTupleSignatures(t *testing.T) {
+	exactInputSingle := NewFunction("exactInputSingle", []Input{{
+		Type: TypeTuple,
+		Components: []Input{
+			{Name: "tokenIn", Type: TypeAddress},
+			{Name: "tokenOut", Type: TypeAddress},
+			{Name: "fee", Type: TypeUint24},
+			{Name: "recipient", Type: TypeAddress},
+			{Name: "deadline", Type: TypeUint256},
+			{Name: "amountIn", Type: TypeUint256},
+			{Name: "amountOutMinimum", Type: TypeUint256},
+			{Name: "sqrtPriceLimitX96", Type: TypeUint160},
+		},
+	}}, []Output{{Type: TypeUint256}})
+	assert.Equal(t, "exactInputSingle((address,address,uint24,address,uint256,uint256,uint256,uint160))", exactInputSingle.Signature())
+	assert.Equal(t, "0x414bf389", exactInputSingle.Selector())
+
+	nested := Input{
+		Type: TypeTupleArray,
+		Components: []Input{
+			{Name: "target", Type: TypeAddress},
+			{Name: "inner", Type: TypeTuple + "[2]", Components: []Input{{Type: TypeUint256}, {Type: TypeBytes}}},
+		},
+	}
+	assert.Equal(t, "(address,(uint256,bytes)[2])[]", nested.CanonicalType())
+	assert.Equal(t, TypeAddress, (&Input{Type: TypeAddress}).CanonicalType())
+
+	// Tuple itself (3 tokens: input, type and indexed) plus 2 tokens per component, nested ones included.
+	fn := NewFunction("execute", []Input{nested}, nil)
+	assert.Equal(t, 1+3+2+2+2+2, FunctionTokenCount(fn))
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	tests := []struct {
+		signature string
+		expected  string
+	}{
+		{signature: "Transfer(address,address,uint256)", expected: "Transfer(address,address,uint256)"},
+		{signature: " Transfer(address indexed from, address indexed to, uint value) ", expected: "Transfer(address,address,uint256)"},
+		{signature: "transfer(address payable to, uint256 amount)", expected: "transfer(address,uint256)"},
+		{signature: "multicall(bytes[] calldata data)", expected: "multicall(bytes[])"},
+		{signature: "handleOps((address sender, uint nonce)[] ops, address beneficiary)", expected: "handleOps((address,uint256)[],address)"},
+		{signature: "pause()", expected: "pause()"},
+		{signature: "pause", expected: "pause"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.signature, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeSignature(tt.signature))
+		})
+	}
+
+	assert.Equal(t, "0xa9059cbb", SignatureSelector("transfer(address to, uint amount)"))
+	assert.Equal(t, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", SignatureTopic("Transfer(address indexed, address indexed, uint256)"))
+}
@@ -22,3 +22,39 @@ func (s Standard) ToProto() eip_pb.Standard {
 	}
 	return eip_pb.Standard_UNKNOWN
 }
+
+// Category represents the functional category of an Ethereum standard, e.g. token or bridging.
+type Category string
+
+// String returns the string representation of the Category.
+func (c Category) String() string {
+	return string(c)
+}
+
+const (
+	CategoryToken          Category = "token"          // Fungible, non-fungible and multi tokens, including their extensions.
+	CategoryProxy          Category = "proxy"          // Proxies and upgradeability.
+	CategoryAccess         Category = "access"         // Access control, e.g. ownership.
+	CategoryDefi           Category = "defi"           // Exchanges, lending markets and flash loans.
+	CategoryBridging       Category = "bridging"       // Cross-chain tokens and bridges, e.g. xERC20 or LayerZero OFT.
+	CategoryAccount        Category = "account"        // Account abstraction, e.g. ERC-4337 and ERC-7579.
+	CategoryInfrastructure Category = "infrastructure" // Registries, introspection and other building blocks.
+)
+
+// Status represents the status of an Ethereum Improvement Proposal, see https://eips.ethereum.org/EIPS/eip-1.
+type Status string
+
+// String returns the string representation of the Status.
+func (s Status) String() string {
+	return string(s)
+}
+
+const (
+	StatusDraft     Status = "Draft"
+	StatusReview    Status = "Review"
+	StatusLastCall  Status = "Last Call"
+	StatusFinal     Status = "Final"
+	StatusStagnant  Status = "Stagnant"
+	StatusWithdrawn Status = "Withdrawn"
+	StatusLiving    Status = "Living"
+)
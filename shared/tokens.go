@@ -1,7 +1,7 @@
 package shared
 
 // TokenCount calculates and returns the total number of tokens (inputs and outputs)
-// present in the functions and events of a given ContractStandard.
+// present in the functions, events and errors of a given ContractStandard.
 func TokenCount(cs ContractStandard) int {
 	count := 0
 
@@ -14,6 +14,7 @@ func TokenCount(cs ContractStandard) int {
 				count++
 			}
 			count++ // Indexed is always counted as it's a boolean
+			count += ComponentsTokenCount(input.Components)
 		}
 
 		for _, output := range function.Outputs {
@@ -21,6 +22,7 @@ func TokenCount(cs ContractStandard) int {
 			if len(output.Type) > 0 {
 				count++
 			}
+			count += ComponentsTokenCount(output.Components)
 		}
 	}
 
@@ -33,6 +35,7 @@ func TokenCount(cs ContractStandard) int {
 				count++
 			}
 			count++ // Indexed is always counted as it's a boolean
+			count += ComponentsTokenCount(input.Components)
 		}
 
 		for _, output := range event.Outputs {
@@ -40,6 +43,19 @@ func TokenCount(cs ContractStandard) int {
 			if len(output.Type) > 0 {
 				count++
 			}
+			count += ComponentsTokenCount(output.Components)
+		}
+	}
+
+	for _, e := range cs.Errors {
+		count++
+
+		for _, input := range e.Inputs {
+			count++
+			if len(input.Type) > 0 {
+				count++
+			}
+			count += ComponentsTokenCount(input.Components) // Error parameters are never indexed
 		}
 	}
 
@@ -60,6 +76,7 @@ func FunctionTokenCount(fn Function) int {
 			count++
 		}
 		count++ // Indexed is always counted as it's a boolean
+		count += ComponentsTokenCount(input.Components)
 	}
 
 	for _, output := range fn.Outputs {
@@ -67,6 +84,24 @@ func FunctionTokenCount(fn Function) int {
 		if len(output.Type) > 0 {
 			count++
 		}
+		count += ComponentsTokenCount(output.Components)
+	}
+
+	return count
+}
+
+// ComponentsTokenCount calculates the total number of tokens present in the components of a tuple type.
+// Each component is counted as a token, with an additional one if the type is specified (non-empty),
+// and nested tuple components are counted recursively.
+func ComponentsTokenCount(components []Input) int {
+	count := 0
+
+	for _, component := range components {
+		count++
+		if len(component.Type) > 0 {
+			count++
+		}
+		count += ComponentsTokenCount(component.Components)
 	}
 
 	return count
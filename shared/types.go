@@ -1,6 +1,10 @@
 package shared
 
-import eip_pb "github.com/unpackdev/protos/dist/go/eip"
+import (
+	"slices"
+
+	eip_pb "github.com/unpackdev/protos/dist/go/eip"
+)
 
 // Constants representing common Ethereum data types.
 const (
@@ -27,13 +31,137 @@ const (
 
 	// TypeUint256Array represents an array of Ethereum "uint256" data types.
 	TypeUint256Array = "uint256[]"
+
+	// Data types used by the Uniswap V2, V3 and V4 standards.
+
+	// TypeUint8 represents the Ethereum "uint8" data type.
+	TypeUint8 = "uint8"
+
+	// TypeUint16 represents the Ethereum "uint16" data type.
+	TypeUint16 = "uint16"
+
+	// TypeUint24 represents the Ethereum "uint24" data type.
+	TypeUint24 = "uint24"
+
+	// TypeUint32 represents the Ethereum "uint32" data type.
+	TypeUint32 = "uint32"
+
+	// TypeUint40 represents the Ethereum "uint40" data type.
+	TypeUint40 = "uint40"
+
+	// TypeUint48 represents the Ethereum "uint48" data type.
+	TypeUint48 = "uint48"
+
+	// TypeUint64 represents the Ethereum "uint64" data type.
+	TypeUint64 = "uint64"
+
+	// TypeUint96 represents the Ethereum "uint96" data type.
+	TypeUint96 = "uint96"
+
+	// TypeUint112 represents the Ethereum "uint112" data type.
+	TypeUint112 = "uint112"
+
+	// TypeUint128 represents the Ethereum "uint128" data type.
+	TypeUint128 = "uint128"
+
+	// TypeUint160 represents the Ethereum "uint160" data type.
+	TypeUint160 = "uint160"
+
+	// TypeUint192 represents the Ethereum "uint192" data type.
+	TypeUint192 = "uint192"
+
+	// TypeInt24 represents the Ethereum "int24" data type.
+	TypeInt24 = "int24"
+
+	// TypeInt56 represents the Ethereum "int56" data type.
+	TypeInt56 = "int56"
+
+	// TypeInt128 represents the Ethereum "int128" data type.
+	TypeInt128 = "int128"
+
+	// TypeInt256 represents the Ethereum "int256" data type.
+	TypeInt256 = "int256"
+
+	// TypeBytes4 represents the Ethereum "bytes4" data type.
+	TypeBytes4 = "bytes4"
+
+	// TypeBytesArray represents an array of Ethereum "bytes" data types.
+	TypeBytesArray = "bytes[]"
+
+	// TypeUint32Array represents an array of Ethereum "uint32" data types.
+	TypeUint32Array = "uint32[]"
+
+	// TypeUint64Array represents an array of Ethereum "uint64" data types.
+	TypeUint64Array = "uint64[]"
+
+	// TypeUint160Array represents an array of Ethereum "uint160" data types.
+	TypeUint160Array = "uint160[]"
+
+	// TypeInt56Array represents an array of Ethereum "int56" data types.
+	TypeInt56Array = "int56[]"
+
+	// TypeTuple represents the Ethereum "tuple" data type, used for structs.
+	TypeTuple = "tuple"
+
+	// TypeTupleArray represents an array of Ethereum "tuple" data types.
+	TypeTupleArray = "tuple[]"
 )
 
+// StateMutability represents the state mutability of an Ethereum smart contract function.
+type StateMutability string
+
+// Constants representing Ethereum function state mutability.
+const (
+	// StateMutabilityPure represents a function that neither reads nor modifies the state.
+	StateMutabilityPure StateMutability = "pure"
+
+	// StateMutabilityView represents a function that reads but does not modify the state.
+	StateMutabilityView StateMutability = "view"
+
+	// StateMutabilityNonPayable represents a function that modifies the state and rejects Ether.
+	StateMutabilityNonPayable StateMutability = "nonpayable"
+
+	// StateMutabilityPayable represents a function that modifies the state and accepts Ether.
+	StateMutabilityPayable StateMutability = "payable"
+)
+
+// String returns the string representation of the state mutability.
+func (m StateMutability) String() string {
+	return string(m)
+}
+
+// Allows reports whether a function declared with the provided (actual) state mutability satisfies
+// this (expected) state mutability. It follows Solidity override rules: mutability may only be
+// changed to a stricter one (nonpayable -> view -> pure), while payable cannot be changed at all.
+// Unknown (empty) mutability on either side is always allowed.
+func (m StateMutability) Allows(actual StateMutability) bool {
+	if m == "" || actual == "" || m == actual {
+		return true
+	}
+
+	switch m {
+	case StateMutabilityNonPayable:
+		return actual == StateMutabilityView || actual == StateMutabilityPure
+	case StateMutabilityView:
+		return actual == StateMutabilityPure
+	default:
+		return false
+	}
+}
+
 // Input represents an input parameter for Ethereum functions and events.
 type Input struct {
+	// Name specifies the name of the input. It is mostly relevant for named tuple components, while parameters are
+	// only named when parsed out of Solidity sources.
+	Name string `json:"name,omitempty"`
+
 	// Type specifies the Ethereum data type of the input.
 	Type string `json:"type"`
 
+	// Components is a slice of Input structs, representing the members of a tuple (struct) type.
+	// It is set only for tuple types and arrays of tuples, e.g. "tuple" or "tuple[]".
+	Components []Input `json:"components,omitempty"`
+
 	// Indexed indicates whether the input is indexed.
 	// This is particularly relevant for event parameters,
 	// where indexed parameters can be used as a filter for event logs.
@@ -43,6 +171,12 @@ type Input struct {
 	Matched bool `json:"matched"`
 }
 
+// CanonicalType returns the canonical ABI type of the input, where tuples are expanded
+// into their component types, e.g. "(address,uint256)[]".
+func (i *Input) CanonicalType() string {
+	return CanonicalType(i.Type, i.Components)
+}
+
 // ToProto converts the Input to its protobuf representation.
 func (i *Input) ToProto() *eip_pb.Input {
 	return &eip_pb.Input{
@@ -54,13 +188,26 @@ func (i *Input) ToProto() *eip_pb.Input {
 
 // Output represents an output parameter for Ethereum functions and events.
 type Output struct {
+	// Name specifies the name of the output, if it is named within the source or ABI it is parsed out of.
+	Name string `json:"name,omitempty"`
+
 	// Type specifies the Ethereum data type of the output.
 	Type string `json:"type"`
 
+	// Components is a slice of Input structs, representing the members of a tuple (struct) type.
+	// It is set only for tuple types and arrays of tuples, e.g. "tuple" or "tuple[]".
+	Components []Input `json:"components,omitempty"`
+
 	// Matched indicates whether the output has been matched via confidence check.
 	Matched bool `json:"matched"`
 }
 
+// CanonicalType returns the canonical ABI type of the output, where tuples are expanded
+// into their component types, e.g. "(address,uint256)[]".
+func (i *Output) CanonicalType() string {
+	return CanonicalType(i.Type, i.Components)
+}
+
 // ToProto converts the Output to its protobuf representation.
 func (i *Output) ToProto() *eip_pb.Output {
 	return &eip_pb.Output{
@@ -80,6 +227,10 @@ type Function struct {
 	// Outputs is a slice of Output structs, representing the data types of the function's return values.
 	Outputs []Output `json:"outputs"`
 
+	// StateMutability specifies the state mutability of the function, e.g. view or payable.
+	// Empty value means the mutability is unknown and it is not taken into account while matching.
+	StateMutability StateMutability `json:"state_mutability"`
+
 	// Matched indicates whether the input has been matched via confidence check.
 	Matched bool `json:"matched"`
 }
@@ -141,6 +292,18 @@ func (e *Event) ToProto() *eip_pb.Event {
 	}
 }
 
+// Error represents an Ethereum smart contract custom error.
+type Error struct {
+	// Name specifies the name of the error.
+	Name string `json:"name"`
+
+	// Inputs is a slice of Input structs, representing the parameters of the error.
+	Inputs []Input `json:"inputs"`
+
+	// Matched indicates whether the error has been matched via confidence check.
+	Matched bool `json:"matched"`
+}
+
 // ContractStandard represents a standard interface for Ethereum smart contracts,
 // such as the ERC-20 or ERC-721 standards.
 type ContractStandard struct {
@@ -153,8 +316,34 @@ type ContractStandard struct {
 	// Type specifies the type of the contract standard, e.g., ERC20 or ERC721.
 	Type Standard `json:"type"`
 
-	// Stagnant indicates whether the contract standard is stagnant in terms of development.
-	Stagnant bool `json:"stagnant"`
+	// EIP specifies the number of the Ethereum Improvement Proposal defining the standard, e.g. 20.
+	// It is zero for standards not defined by an EIP, such as protocol interfaces.
+	EIP int `json:"eip,omitempty"`
+
+	// Status specifies the EIP status of the contract standard, e.g. Final or Stagnant.
+	// It is empty for standards not defined by an EIP.
+	Status Status `json:"status,omitempty"`
+
+	// Category specifies the functional category of the contract standard, e.g. token or bridging.
+	Category Category `json:"category,omitempty"`
+
+	// Tags holds free-form labels of the contract standard, e.g. "nft" or "lending".
+	Tags []string `json:"tags,omitempty"`
+
+	// Authors lists authors of the contract standard.
+	Authors []string `json:"authors,omitempty"`
+
+	// Requires lists standards the contract standard depends on, e.g. ERC721 requires ERC165.
+	Requires []Standard `json:"requires,omitempty"`
+
+	// Extends lists standards the contract standard is an extension of, e.g. ERC2981 extends ERC721 and ERC1155.
+	Extends []Standard `json:"extends,omitempty"`
+
+	// Created specifies the date the contract standard was created, in the YYYY-MM-DD format.
+	Created string `json:"created,omitempty"`
+
+	// Version identifies the definition among versions of the contract standard, e.g. "v5" or "final".
+	Version string `json:"version,omitempty"`
 
 	// ABI specifies the ABI of the contract standard.
 	ABI string `json:"abi"`
@@ -164,6 +353,13 @@ type ContractStandard struct {
 
 	// Events is a slice of Event structs, representing the events defined in the contract standard.
 	Events []Event `json:"events"`
+
+	// Errors is a slice of Error structs, representing the custom errors defined in the contract standard.
+	Errors []Error `json:"errors"`
+
+	// Versions holds superseded definitions of the contract standard, such as OpenZeppelin v4 contracts or pre-final
+	// EIP drafts. Each version sets its Version, ABI and members, while the remaining fields are inherited.
+	Versions []ContractStandard `json:"versions,omitempty"`
 }
 
 // ToProto converts the ContractStandard to its protobuf representation.
@@ -178,21 +374,66 @@ func (cs *ContractStandard) ToProto() *eip_pb.ContractStandard {
 		protoEvents[idx] = event.ToProto()
 	}
 
-	standard, err := GetProtoStandardFromString(cs.Type.String())
-	if err != nil {
-		panic(err)
-	}
-
 	return &eip_pb.ContractStandard{
 		Name:      cs.Name,
 		Url:       cs.Url,
-		Type:      standard,
-		Stagnant:  cs.Stagnant,
+		Type:      cs.Type.ToProto(),
+		Stagnant:  cs.Status == StatusStagnant,
 		Functions: protoFunctions,
 		Events:    protoEvents,
 	}
 }
 
+// Clone returns a deep copy of the ContractStandard, so modifying the copy leaves the original definition intact.
+func (cs ContractStandard) Clone() ContractStandard {
+	cs.Tags = slices.Clone(cs.Tags)
+	cs.Authors = slices.Clone(cs.Authors)
+	cs.Requires = slices.Clone(cs.Requires)
+	cs.Extends = slices.Clone(cs.Extends)
+
+	cs.Functions = slices.Clone(cs.Functions)
+	for idx := range cs.Functions {
+		cs.Functions[idx].Inputs = cloneInputs(cs.Functions[idx].Inputs)
+		cs.Functions[idx].Outputs = cloneOutputs(cs.Functions[idx].Outputs)
+	}
+
+	cs.Events = slices.Clone(cs.Events)
+	for idx := range cs.Events {
+		cs.Events[idx].Inputs = cloneInputs(cs.Events[idx].Inputs)
+		cs.Events[idx].Outputs = cloneOutputs(cs.Events[idx].Outputs)
+	}
+
+	cs.Errors = slices.Clone(cs.Errors)
+	for idx := range cs.Errors {
+		cs.Errors[idx].Inputs = cloneInputs(cs.Errors[idx].Inputs)
+	}
+
+	cs.Versions = slices.Clone(cs.Versions)
+	for idx := range cs.Versions {
+		cs.Versions[idx] = cs.Versions[idx].Clone()
+	}
+
+	return cs
+}
+
+// cloneInputs returns a deep copy of the inputs, including tuple components.
+func cloneInputs(inputs []Input) []Input {
+	toReturn := slices.Clone(inputs)
+	for idx := range toReturn {
+		toReturn[idx].Components = cloneInputs(toReturn[idx].Components)
+	}
+	return toReturn
+}
+
+// cloneOutputs returns a deep copy of the outputs, including tuple components.
+func cloneOutputs(outputs []Output) []Output {
+	toReturn := slices.Clone(outputs)
+	for idx := range toReturn {
+		toReturn[idx].Components = cloneInputs(toReturn[idx].Components)
+	}
+	return toReturn
+}
+
 // ContractMatcher represents an Ethereum smart contract that attempts to confirm to a standard interface,
 // such as the ERC-20 or ERC-721 standards. Used while performing a contract standard detection.
 type ContractMatcher struct {
@@ -204,6 +445,9 @@ type ContractMatcher struct {
 
 	// Events is a slice of Event structs, representing the events defined in the contract standard.
 	Events []Event `json:"events"`
+
+	// Errors is a slice of Error structs, representing the custom errors defined in the contract.
+	Errors []Error `json:"errors"`
 }
 
 // ToProto converts the Event to its protobuf representation.
@@ -226,6 +470,53 @@ func (c *ContractMatcher) ToProto() *eip_pb.Contract {
 	}
 }
 
+// NewContractMatcherFromProto creates a new ContractMatcher out of its protobuf representation.
+// Protobuf contracts carry neither parameter names nor tuple components, so tuple parameters are expected
+// to be provided in their canonical form, e.g. "(address,uint256)[]". Matched flags are not carried over.
+func NewContractMatcherFromProto(c *eip_pb.Contract) *ContractMatcher {
+	toReturn := &ContractMatcher{
+		Name:      c.GetName(),
+		Functions: make([]Function, 0, len(c.GetFunctions())),
+		Events:    make([]Event, 0, len(c.GetEvents())),
+	}
+
+	for _, fn := range c.GetFunctions() {
+		toReturn.Functions = append(toReturn.Functions, Function{
+			Name:    fn.GetName(),
+			Inputs:  inputsFromProto(fn.GetInputs()),
+			Outputs: outputsFromProto(fn.GetOutputs()),
+		})
+	}
+
+	for _, event := range c.GetEvents() {
+		toReturn.Events = append(toReturn.Events, Event{
+			Name:    event.GetName(),
+			Inputs:  inputsFromProto(event.GetInputs()),
+			Outputs: outputsFromProto(event.GetOutputs()),
+		})
+	}
+
+	return toReturn
+}
+
+// inputsFromProto converts protobuf inputs into Input structs.
+func inputsFromProto(inputs []*eip_pb.Input) []Input {
+	toReturn := make([]Input, 0, len(inputs))
+	for _, input := range inputs {
+		toReturn = append(toReturn, Input{Type: input.GetType(), Indexed: input.GetIndexed()})
+	}
+	return toReturn
+}
+
+// outputsFromProto converts protobuf outputs into Output structs.
+func outputsFromProto(outputs []*eip_pb.Output) []Output {
+	toReturn := make([]Output, 0, len(outputs))
+	for _, output := range outputs {
+		toReturn = append(toReturn, Output{Type: output.GetType()})
+	}
+	return toReturn
+}
+
 // FunctionMatcher represents an Ethereum smart contract focusing on matching specific functions
 // to a standard interface, such as those defined by ERC-20 or ERC-721 standards.
 type FunctionMatcher struct {
@@ -238,26 +529,59 @@ type FunctionMatcher struct {
 
 // Discovery represents the result of attempting to discover a contract standard.
 type Discovery struct {
-	Confidence       ConfidenceLevel     `json:"confidence"`        // Confidence level of the discovery.
-	ConfidencePoints float64             `json:"confidence_points"` // Confidence points of the discovery.
-	Threshold        ConfidenceThreshold `json:"threshold"`         // Threshold level of the discovery.
-	MaximumTokens    int                 `json:"maximum_tokens"`    // Maximum number of tokens in the standard.
-	DiscoveredTokens int                 `json:"discovered_tokens"` // Number of tokens discovered in the standard.
-	Standard         Standard            `json:"standard"`          // Contract standard being scanned.
-	Contract         *ContractMatcher    `json:"contract"`          // Contract including matched functions and events.
+	Confidence       ConfidenceLevel     `json:"confidence"`         // Confidence level of the discovery.
+	ConfidencePoints float64             `json:"confidence_points"`  // Confidence points of the discovery.
+	Threshold        ConfidenceThreshold `json:"threshold"`          // Threshold level of the discovery.
+	MaximumTokens    int                 `json:"maximum_tokens"`     // Maximum number of tokens in the standard.
+	DiscoveredTokens int                 `json:"discovered_tokens"`  // Number of tokens discovered in the standard.
+	Standard         Standard            `json:"standard"`           // Contract standard being scanned.
+	Category         Category            `json:"category,omitempty"` // Category of the contract standard being scanned.
+	Version          string              `json:"version,omitempty"`  // Version of the contract standard matched best.
+	Contract         *ContractMatcher    `json:"contract"`           // Contract including matched functions and events.
+	Deviations       []Deviation         `json:"deviations"`         // Deviations found while matching the contract.
 }
 
 // ToProto converts the Discovery to its protobuf representation.
 func (d *Discovery) ToProto() *eip_pb.Discovery {
-	return &eip_pb.Discovery{
+	toReturn := &eip_pb.Discovery{
 		Standard:         d.Standard.ToProto(),
 		Confidence:       d.Confidence.ToProto(),
 		ConfidencePoints: int32(d.ConfidencePoints * 100),
 		Threshold:        d.Threshold.ToProto(),
 		MaximumTokens:    int32(d.MaximumTokens),
 		DiscoveredTokens: int32(d.DiscoveredTokens),
-		Contract:         d.Contract.ToProto(),
 	}
+
+	// Contract is not present in score only discoveries.
+	if d.Contract != nil {
+		toReturn.Contract = d.Contract.ToProto()
+	}
+
+	return toReturn
+}
+
+// DeviationKind represents the kind of deviation found between a contract and a standard.
+type DeviationKind string
+
+const (
+	// DeviationStateMutability represents a function whose state mutability is not allowed by the standard.
+	DeviationStateMutability DeviationKind = "state_mutability"
+
+	// DeviationSuspiciousLookalike represents a member whose name only resembles the standard member name,
+	// e.g. different casing, trailing underscore or swapped letters, which is a common trick in scam contracts.
+	DeviationSuspiciousLookalike DeviationKind = "suspicious_lookalike"
+
+	// DeviationTopicCount represents an observed event log whose number of topics differs from the number of
+	// indexed parameters of the standard event, e.g. an ERC-721 Transfer observed while inferring ERC-20.
+	DeviationTopicCount DeviationKind = "topic_count"
+)
+
+// Deviation represents a difference between a contract member and the standard member it was matched against.
+type Deviation struct {
+	Kind     DeviationKind `json:"kind"`     // Kind of the deviation.
+	Member   string        `json:"member"`   // Signature of the contract member that deviates.
+	Expected string        `json:"expected"` // Value expected by the standard.
+	Actual   string        `json:"actual"`   // Value found in the contract.
 }
 
 // FunctionDiscovery represents the result of attempting to discover a function within a contract standard.
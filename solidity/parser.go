@@ -0,0 +1,356 @@
+package solidity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	sp "github.com/unpackdev/solgo/parser"
+	"github.com/unpackdev/standards/shared"
+)
+
+// typeExpr represents a parsed, not yet resolved, Solidity type name.
+type typeExpr struct {
+	name   string    // Base type name, possibly qualified (e.g. "IPool.Slot0"), or "mapping".
+	suffix string    // Array suffix, e.g. "[]" or "[2][]".
+	key    *typeExpr // Mapping key type.
+	value  *typeExpr // Mapping value type.
+}
+
+// param represents a parsed function, event or error parameter, or a struct field.
+type param struct {
+	typ     *typeExpr
+	name    string
+	indexed bool
+}
+
+// function represents a parsed function declaration or public state variable.
+type function struct {
+	name       string
+	inputs     []param
+	outputs    []param
+	visibility string
+	mutability string
+	getter     *typeExpr // Type of the public state variable the function is a getter of.
+	line       int
+}
+
+// declaration represents a parsed event or custom error declaration.
+type declaration struct {
+	name   string
+	params []param
+	line   int
+}
+
+// unit represents a parsed contract, interface or library.
+type unit struct {
+	name        string
+	kind        Kind
+	bases       []string
+	functions   []function
+	events      []declaration
+	errors      []declaration
+	identifiers map[string]bool // Identifiers referenced within the definition, used to resolve file-level declarations.
+	line        int
+}
+
+// unitContext represents the parse tree of a contract, interface or library definition.
+type unitContext interface {
+	antlr.ParserRuleContext
+	AllContractBodyElement() []sp.IContractBodyElementContext
+}
+
+// parser collects definitions of a single Solidity source unit out of its solgo parse tree.
+type parser struct {
+	tokens *antlr.CommonTokenStream
+
+	units   []*unit
+	errors  []declaration // File-level custom errors.
+	structs map[string][]param
+	enums   map[string]bool
+	aliases map[string]*typeExpr
+	types   map[string]bool // Names of contracts, interfaces and libraries.
+
+	typeAliases shared.TypeAliases // User-defined value types of the parsing options.
+	unresolved  map[string]bool    // Type names not defined in the source, collected per flattened contract.
+}
+
+// newParser creates a new parser.
+func newParser() *parser {
+	return &parser{
+		structs: make(map[string][]param),
+		enums:   make(map[string]bool),
+		aliases: make(map[string]*typeExpr),
+		types:   make(map[string]bool),
+	}
+}
+
+// errorListener records the first syntax error reported while lexing or parsing the source.
+type errorListener struct {
+	*antlr.DefaultErrorListener
+	err error
+}
+
+// SyntaxError records the syntax error unless an earlier one was already recorded.
+func (l *errorListener) SyntaxError(_ antlr.Recognizer, _ interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+	if l.err == nil {
+		l.err = fmt.Errorf("syntax error at line %d:%d: %s", line, column, msg)
+	}
+}
+
+// parse parses the source with the solgo Solidity grammar and collects all of its top-level definitions.
+func (p *parser) parse(src []byte) error {
+	listener := &errorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+
+	lexer := sp.NewSolidityLexer(antlr.NewInputStream(string(src)))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(listener)
+
+	p.tokens = antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	solidityParser := sp.NewSolidityParser(p.tokens)
+	solidityParser.RemoveErrorListeners()
+	solidityParser.AddErrorListener(listener)
+
+	tree := solidityParser.SourceUnit()
+	if listener.err != nil {
+		return listener.err
+	}
+
+	for _, child := range tree.GetChildren() {
+		switch ctx := child.(type) {
+		case *sp.ContractDefinitionContext:
+			kind := KindContract
+			if ctx.Abstract() != nil {
+				kind = KindAbstract
+			}
+			p.parseUnit(ctx, kind, ctx.GetName(), ctx.InheritanceSpecifierList())
+		case *sp.InterfaceDefinitionContext:
+			p.parseUnit(ctx, KindInterface, ctx.GetName(), ctx.InheritanceSpecifierList())
+		case *sp.LibraryDefinitionContext:
+			p.parseUnit(ctx, KindLibrary, ctx.GetName(), nil)
+		case *sp.StructDefinitionContext:
+			p.parseStruct(ctx, "")
+		case *sp.EnumDefinitionContext:
+			p.parseEnum(ctx, "")
+		case *sp.UserDefinedValueTypeDefinitionContext:
+			p.parseUserDefinedValueType(ctx, "")
+		case *sp.ErrorDefinitionContext:
+			p.errors = append(p.errors, p.parseError(ctx))
+		}
+	}
+
+	return nil
+}
+
+// parseUnit collects a contract, interface or library definition.
+func (p *parser) parseUnit(ctx unitContext, kind Kind, name sp.IIdentifierContext, inheritance sp.IInheritanceSpecifierListContext) {
+	u := &unit{name: name.GetText(), kind: kind, identifiers: make(map[string]bool), line: name.GetStart().GetLine()}
+	p.types[u.name] = true
+	p.units = append(p.units, u)
+
+	if inheritance != nil {
+		for _, base := range inheritance.AllInheritanceSpecifier() {
+			u.bases = append(u.bases, lastSegment(base.GetName().GetText()))
+		}
+	}
+
+	for _, element := range ctx.AllContractBodyElement() {
+		switch {
+		case element.FunctionDefinition() != nil:
+			u.functions = append(u.functions, p.parseFunction(element.FunctionDefinition()))
+		case element.StateVariableDeclaration() != nil:
+			if fn := p.parseStateVariable(element.StateVariableDeclaration()); fn != nil {
+				u.functions = append(u.functions, *fn)
+			}
+		case element.EventDefinition() != nil:
+			u.events = append(u.events, p.parseEvent(element.EventDefinition()))
+		case element.ErrorDefinition() != nil:
+			u.errors = append(u.errors, p.parseError(element.ErrorDefinition()))
+		case element.StructDefinition() != nil:
+			p.parseStruct(element.StructDefinition(), u.name)
+		case element.EnumDefinition() != nil:
+			p.parseEnum(element.EnumDefinition(), u.name)
+		case element.UserDefinedValueTypeDefinition() != nil:
+			p.parseUserDefinedValueType(element.UserDefinedValueTypeDefinition(), u.name)
+		}
+	}
+
+	// Constructors, modifiers and function bodies may reference file-level declarations, e.g. custom errors.
+	for i := ctx.GetStart().GetTokenIndex(); i <= ctx.GetStop().GetTokenIndex(); i++ {
+		if tok := p.tokens.Get(i); tok.GetTokenType() == sp.SolidityLexerIdentifier {
+			u.identifiers[tok.GetText()] = true
+		}
+	}
+}
+
+// register registers the definition under its bare name and, for definitions within contracts, its qualified name.
+func register[T any](definitions map[string]T, name string, scope string, value T) {
+	definitions[name] = value
+	if scope != "" {
+		definitions[scope+"."+name] = value
+	}
+}
+
+// parseStruct collects a struct definition.
+func (p *parser) parseStruct(ctx sp.IStructDefinitionContext, scope string) {
+	fields := make([]param, 0)
+	for _, member := range ctx.AllStructMember() {
+		fields = append(fields, param{typ: p.parseType(member.TypeName()), name: member.GetName().GetText()})
+	}
+	register(p.structs, ctx.GetName().GetText(), scope, fields)
+}
+
+// parseEnum collects an enum definition.
+func (p *parser) parseEnum(ctx sp.IEnumDefinitionContext, scope string) {
+	register(p.enums, ctx.GetName().GetText(), scope, true)
+}
+
+// parseUserDefinedValueType collects a user-defined value type definition.
+func (p *parser) parseUserDefinedValueType(ctx sp.IUserDefinedValueTypeDefinitionContext, scope string) {
+	register(p.aliases, ctx.GetName().GetText(), scope, parseElementaryType(ctx.ElementaryTypeName()))
+}
+
+// parseEvent collects an event definition.
+func (p *parser) parseEvent(ctx sp.IEventDefinitionContext) declaration {
+	toReturn := declaration{name: ctx.GetName().GetText(), params: make([]param, 0), line: ctx.GetName().GetStart().GetLine()}
+	for _, prm := range ctx.AllEventParameter() {
+		parsed := param{typ: p.parseType(prm.TypeName()), indexed: prm.Indexed() != nil}
+		if prm.GetName() != nil {
+			parsed.name = prm.GetName().GetText()
+		}
+		toReturn.params = append(toReturn.params, parsed)
+	}
+	return toReturn
+}
+
+// parseError collects a custom error definition.
+func (p *parser) parseError(ctx sp.IErrorDefinitionContext) declaration {
+	toReturn := declaration{name: ctx.GetName().GetText(), params: make([]param, 0), line: ctx.GetName().GetStart().GetLine()}
+	for _, prm := range ctx.AllErrorParameter() {
+		parsed := param{typ: p.parseType(prm.TypeName())}
+		if prm.GetName() != nil {
+			parsed.name = prm.GetName().GetText()
+		}
+		toReturn.params = append(toReturn.params, parsed)
+	}
+	return toReturn
+}
+
+// parseFunction collects a function definition.
+func (p *parser) parseFunction(ctx sp.IFunctionDefinitionContext) function {
+	toReturn := function{inputs: p.parseParams(ctx.GetArguments()), line: ctx.GetStart().GetLine()}
+
+	// Functions named after the special fallback and receive functions are lexed as keywords.
+	switch {
+	case ctx.Identifier() != nil:
+		toReturn.name, toReturn.line = ctx.Identifier().GetText(), ctx.Identifier().GetStart().GetLine()
+	case ctx.Fallback() != nil:
+		toReturn.name = ctx.Fallback().GetText()
+	case ctx.Receive() != nil:
+		toReturn.name = ctx.Receive().GetText()
+	}
+
+	if visibility := ctx.AllVisibility(); len(visibility) > 0 {
+		toReturn.visibility = visibility[0].GetText()
+	}
+	if mutability := ctx.AllStateMutability(); len(mutability) > 0 {
+		toReturn.mutability = mutability[0].GetText()
+	}
+	if ctx.GetReturnParameters() != nil {
+		toReturn.outputs = p.parseParams(ctx.GetReturnParameters())
+	}
+
+	return toReturn
+}
+
+// parseStateVariable collects a state variable declaration, returning its getter function if it is public.
+func (p *parser) parseStateVariable(ctx sp.IStateVariableDeclarationContext) *function {
+	if len(ctx.AllPublic()) == 0 {
+		return nil
+	}
+
+	toReturn := getter(ctx.GetName().GetText(), p.parseType(ctx.TypeName()))
+	toReturn.line = ctx.GetName().GetStart().GetLine()
+	return toReturn
+}
+
+// getter builds the getter function of a public state variable. Mapping keys and array indexes become inputs,
+// while the remaining value type becomes the output.
+func getter(name string, typ *typeExpr) *function {
+	fn := &function{name: name, visibility: "public", mutability: "view", inputs: make([]param, 0)}
+
+	for {
+		if typ.name == "mapping" {
+			fn.inputs = append(fn.inputs, param{typ: typ.key})
+			typ = typ.value
+			continue
+		}
+
+		if idx := strings.LastIndex(typ.suffix, "["); idx >= 0 {
+			fn.inputs = append(fn.inputs, param{typ: &typeExpr{name: "uint256"}})
+			typ = &typeExpr{name: typ.name, suffix: typ.suffix[:idx]}
+			continue
+		}
+
+		break
+	}
+
+	fn.getter = typ
+	return fn
+}
+
+// parseParams collects a function parameter list.
+func (p *parser) parseParams(ctx sp.IParameterListContext) []param {
+	toReturn := make([]param, 0)
+	if ctx == nil {
+		return toReturn
+	}
+
+	for _, prm := range ctx.AllParameterDeclaration() {
+		parsed := param{typ: p.parseType(prm.TypeName())}
+		if prm.GetName() != nil {
+			parsed.name = prm.GetName().GetText()
+		}
+		toReturn = append(toReturn, parsed)
+	}
+	return toReturn
+}
+
+// parseType collects a type name, including qualified names, mappings and array suffixes.
+func (p *parser) parseType(ctx sp.ITypeNameContext) *typeExpr {
+	switch {
+	case ctx.TypeName() != nil:
+		// Arrays nest the element type, so suffixes of the outer dimensions are appended last.
+		toReturn := p.parseType(ctx.TypeName())
+		size := ""
+		if ctx.Expression() != nil {
+			size = ctx.Expression().GetText()
+		}
+		toReturn.suffix += "[" + size + "]"
+		return toReturn
+	case ctx.ElementaryTypeName() != nil:
+		return parseElementaryType(ctx.ElementaryTypeName())
+	case ctx.FunctionTypeName() != nil:
+		// Function types are encoded as 24 bytes (address and selector), their signature is irrelevant.
+		return &typeExpr{name: "function"}
+	case ctx.MappingType() != nil:
+		mapping := ctx.MappingType()
+		toReturn := &typeExpr{name: "mapping", value: p.parseType(mapping.GetValue())}
+		if key := mapping.GetKey(); key.ElementaryTypeName() != nil {
+			toReturn.key = parseElementaryType(key.ElementaryTypeName())
+		} else {
+			toReturn.key = &typeExpr{name: key.IdentifierPath().GetText()}
+		}
+		return toReturn
+	default:
+		return &typeExpr{name: ctx.IdentifierPath().GetText()}
+	}
+}
+
+// parseElementaryType collects an elementary type name, dropping the payable modifier of addresses.
+func parseElementaryType(ctx sp.IElementaryTypeNameContext) *typeExpr {
+	if ctx.Address() != nil {
+		return &typeExpr{name: shared.TypeAddress}
+	}
+	return &typeExpr{name: ctx.GetText()}
+}
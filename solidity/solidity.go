@@ -0,0 +1,336 @@
+// Package solidity extracts the external interface of contracts, interfaces and libraries out of Solidity source:
+// external and public functions (including public state variable getters), events and custom errors, with types
+// resolved to their canonical ABI representation. Sources are parsed with the solgo Solidity grammar, which
+// supports Solidity 0.5 and later.
+//
+// It does not compile the source, so imported definitions are not resolved: unknown type names are assumed to
+// be contracts or interfaces (and therefore addresses) and members inherited from contracts that are not defined
+// within the same source are not included. Imported structs, enums and user-defined value types therefore resolve
+// to the wrong ABI type, and the signatures and selectors of members using them are wrong; such type names are
+// listed in Contract.Unresolved. Flattened sources are parsed in full.
+package solidity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/unpackdev/standards/shared"
+)
+
+// Kind represents the kind of a Solidity contract definition.
+type Kind string
+
+const (
+	KindContract  Kind = "contract"          // Regular contract.
+	KindAbstract  Kind = "abstract contract" // Abstract contract.
+	KindInterface Kind = "interface"         // Interface.
+	KindLibrary   Kind = "library"           // Library.
+)
+
+// Contract represents the external interface of a contract, interface or library defined in Solidity source.
+// Members inherited from bases defined within the same source are included.
+type Contract struct {
+	Name      string            `json:"name"`      // Name of the contract.
+	Kind      Kind              `json:"kind"`      // Kind of the contract definition.
+	Bases     []string          `json:"bases"`     // Names of the directly inherited contracts.
+	Functions []shared.Function `json:"functions"` // External and public functions, including state variable getters.
+	Events    []shared.Event    `json:"events"`    // Events declared in the contract.
+	Errors    []shared.Error    `json:"errors"`    // Custom errors declared in or used by the contract.
+	Line      int               `json:"line"`      // Line of the contract definition.
+
+	// Unresolved lists type names used by the members that are not defined within the source, in sorted order.
+	// They are assumed to be contracts or interfaces and resolved to address, which is wrong for imported
+	// structs, enums and user-defined value types, so signatures of members using them may not be reliable.
+	Unresolved []string `json:"unresolved"`
+
+	lines map[string]int // Definition lines of members, keyed by their kind and signature.
+}
+
+// MemberLine returns the line the member of the provided kind ("function", "event" or "error") and canonical
+// signature is defined at, or 0 if the contract has no such member. Members inherited from bases defined within
+// the same source point to the base definition, and file-level errors to their file-level definition.
+func (c *Contract) MemberLine(kind string, signature string) int {
+	return c.lines[kind+":"+signature]
+}
+
+// ToContractMatcher converts the contract into a ContractMatcher used while performing standard detection.
+func (c *Contract) ToContractMatcher() *shared.ContractMatcher {
+	return &shared.ContractMatcher{
+		Name:      c.Name,
+		Functions: c.Functions,
+		Events:    c.Events,
+		Errors:    c.Errors,
+	}
+}
+
+// Options represents options of parsing Solidity sources.
+type Options struct {
+	// TypeAliases resolves user-defined value types imported from sources that are not parsed, e.g. Uniswap V4
+	// Currency or BalanceDelta. Types declared within the parsed source take precedence.
+	TypeAliases shared.TypeAliases
+}
+
+// Parse parses the provided Solidity source and returns all contracts, interfaces and libraries it defines,
+// in order of their definition. Types that are not defined within the source are assumed to be addresses and are
+// listed in Contract.Unresolved.
+func Parse(src []byte) ([]*Contract, error) {
+	return ParseWithOptions(src, Options{})
+}
+
+// ParseWithOptions parses the provided Solidity source, as Parse does, using the provided options.
+func ParseWithOptions(src []byte, opts Options) ([]*Contract, error) {
+	p := newParser()
+	p.typeAliases = opts.TypeAliases
+	if err := p.parse(src); err != nil {
+		return nil, fmt.Errorf("failure to parse solidity source: %w", err)
+	}
+
+	units := make(map[string]*unit, len(p.units))
+	for _, u := range p.units {
+		units[u.name] = u
+	}
+
+	toReturn := make([]*Contract, 0, len(p.units))
+	for _, u := range p.units {
+		contract := &Contract{
+			Name:      u.name,
+			Kind:      u.kind,
+			Bases:     u.bases,
+			Functions: make([]shared.Function, 0),
+			Events:    make([]shared.Event, 0),
+			Errors:    make([]shared.Error, 0),
+			Line:      u.line,
+			lines:     make(map[string]int),
+		}
+
+		p.unresolved = make(map[string]bool)
+		p.flatten(contract, u, units, map[string]bool{}, map[string]bool{})
+
+		contract.Unresolved = make([]string, 0, len(p.unresolved))
+		for name := range p.unresolved {
+			contract.Unresolved = append(contract.Unresolved, name)
+		}
+		sort.Strings(contract.Unresolved)
+		toReturn = append(toReturn, contract)
+	}
+
+	return toReturn, nil
+}
+
+// NewContractMatcherFromSource creates a new ContractMatcher out of the provided Solidity source. The contract
+// with the provided name is used, or, if the name is empty, the last defined non-abstract contract, which is
+// the main contract of flattened sources.
+func NewContractMatcherFromSource(name string, src []byte) (*shared.ContractMatcher, error) {
+	contracts, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract := Find(contracts, name); contract != nil {
+		return contract.ToContractMatcher(), nil
+	}
+
+	if name != "" {
+		return nil, fmt.Errorf("contract %s not found in solidity source", name)
+	}
+	return nil, fmt.Errorf("no contracts found in solidity source")
+}
+
+// Find returns the contract with the provided name, or, if the name is empty, the main contract, that is the last
+// defined contract preferring regular contracts over abstract contracts and interfaces. Libraries are never
+// considered the main contract. It returns nil if there is no such contract.
+func Find(contracts []*Contract, name string) *Contract {
+	if name != "" {
+		for _, contract := range contracts {
+			if contract.Name == name {
+				return contract
+			}
+		}
+		return nil
+	}
+
+	for _, kind := range []Kind{KindContract, KindAbstract, KindInterface} {
+		for i := len(contracts) - 1; i >= 0; i-- {
+			if contracts[i].Kind == kind {
+				return contracts[i]
+			}
+		}
+	}
+	return nil
+}
+
+// flatten adds members of the unit and of its bases, recursively, into the contract. Members that are already
+// present, by their signature, are skipped so that overrides take precedence over base definitions.
+func (p *parser) flatten(contract *Contract, u *unit, units map[string]*unit, visited, seen map[string]bool) {
+	if visited[u.name] {
+		return
+	}
+	visited[u.name] = true
+
+	for _, fn := range u.functions {
+		if fn.visibility == "internal" || fn.visibility == "private" {
+			continue
+		}
+
+		resolved := p.resolveFunction(fn)
+		if key := "function:" + resolved.Signature(); !seen[key] {
+			seen[key] = true
+			contract.lines[key] = fn.line
+			contract.Functions = append(contract.Functions, resolved)
+		}
+	}
+
+	for _, decl := range u.events {
+		event := shared.NewEvent(decl.name, p.resolveParams(decl.params, true), nil)
+		if key := "event:" + event.Signature(); !seen[key] {
+			seen[key] = true
+			contract.lines[key] = decl.line
+			contract.Events = append(contract.Events, event)
+		}
+	}
+
+	errors := append(append([]declaration{}, u.errors...), p.used(p.errors, u)...)
+	for _, decl := range errors {
+		e := shared.NewError(decl.name, p.resolveParams(decl.params, false))
+		if key := "error:" + e.Signature(); !seen[key] {
+			seen[key] = true
+			contract.lines[key] = decl.line
+			contract.Errors = append(contract.Errors, e)
+		}
+	}
+
+	// Bases are listed from the most base-like to the most derived, so they are visited in the reverse order.
+	for i := len(u.bases) - 1; i >= 0; i-- {
+		if base, ok := units[u.bases[i]]; ok {
+			p.flatten(contract, base, units, visited, seen)
+		}
+	}
+}
+
+// used returns file-level declarations referenced within the unit.
+func (p *parser) used(decls []declaration, u *unit) []declaration {
+	toReturn := make([]declaration, 0)
+	for _, decl := range decls {
+		if u.identifiers[decl.name] {
+			toReturn = append(toReturn, decl)
+		}
+	}
+	return toReturn
+}
+
+// resolveFunction converts the parsed function into a Function with resolved types.
+func (p *parser) resolveFunction(fn function) shared.Function {
+	var outputs []shared.Output
+	switch {
+	case fn.getter != nil && p.structFields(fn.getter) != nil:
+		// Getters of struct variables return struct members, omitting arrays and mappings.
+		for _, field := range p.structFields(fn.getter) {
+			if field.typ.name == "mapping" || field.typ.suffix != "" {
+				continue
+			}
+			input := p.resolveType(field.typ)
+			outputs = append(outputs, shared.Output{Name: field.name, Type: input.Type, Components: input.Components})
+		}
+	case fn.getter != nil:
+		input := p.resolveType(fn.getter)
+		outputs = []shared.Output{{Type: input.Type, Components: input.Components}}
+	default:
+		for _, prm := range fn.outputs {
+			input := p.resolveType(prm.typ)
+			outputs = append(outputs, shared.Output{Name: prm.name, Type: input.Type, Components: input.Components})
+		}
+	}
+
+	toReturn := shared.NewFunction(fn.name, p.resolveParams(fn.inputs, false), outputs)
+	switch fn.mutability {
+	case "":
+		toReturn.StateMutability = shared.StateMutabilityNonPayable
+	default:
+		toReturn.StateMutability = shared.StateMutability(fn.mutability)
+	}
+	return toReturn
+}
+
+// resolveParams converts parsed parameters into named inputs with resolved types.
+func (p *parser) resolveParams(params []param, indexed bool) []shared.Input {
+	if len(params) == 0 {
+		return nil
+	}
+
+	toReturn := make([]shared.Input, 0, len(params))
+	for _, prm := range params {
+		input := p.resolveType(prm.typ)
+		input.Name = prm.name
+		input.Indexed = indexed && prm.indexed
+		toReturn = append(toReturn, input)
+	}
+	return toReturn
+}
+
+// elementaryType matches Solidity elementary type names.
+var elementaryType = regexp.MustCompile(`^(address|bool|string|bytes([1-9]|[12][0-9]|3[0-2])?|byte|u?int([0-9]+)?|u?fixed([0-9]+x[0-9]+)?)$`)
+
+// resolveType resolves the parsed type into an input with its ABI type and, for structs, named components.
+func (p *parser) resolveType(typ *typeExpr) shared.Input {
+	return p.resolveTypeDepth(typ, 0)
+}
+
+// resolveTypeDepth implements resolveType, guarding against recursive struct definitions.
+func (p *parser) resolveTypeDepth(typ *typeExpr, depth int) shared.Input {
+	switch {
+	case typ.name == "function":
+		return shared.Input{Type: "bytes24" + typ.suffix}
+	case elementaryType.MatchString(typ.name):
+		return shared.Input{Type: shared.NormalizeType(typ.name + typ.suffix)}
+	case p.enums[typ.name] || p.enums[lastSegment(typ.name)]:
+		return shared.Input{Type: shared.TypeUint8 + typ.suffix}
+	}
+
+	if alias, ok := p.aliases[typ.name]; ok {
+		return shared.Input{Type: shared.NormalizeType(alias.name + typ.suffix)}
+	}
+	if alias, ok := p.aliases[lastSegment(typ.name)]; ok {
+		return shared.Input{Type: shared.NormalizeType(alias.name + typ.suffix)}
+	}
+
+	if fields := p.structFields(typ); fields != nil && depth < 16 {
+		components := make([]shared.Input, 0, len(fields))
+		for _, field := range fields {
+			component := p.resolveTypeDepth(field.typ, depth+1)
+			component.Name = field.name
+			components = append(components, component)
+		}
+		return shared.Input{Type: shared.TypeTuple + typ.suffix, Components: components}
+	}
+
+	// User-defined value types of the parsing options, otherwise contracts and interfaces, including imported ones.
+	if _, ok := p.typeAliases[typ.name]; ok {
+		return shared.Input{Type: p.typeAliases.Normalize(typ.name + typ.suffix)}
+	}
+	if _, ok := p.typeAliases[lastSegment(typ.name)]; ok {
+		return shared.Input{Type: p.typeAliases.Normalize(lastSegment(typ.name) + typ.suffix)}
+	}
+	if !p.types[typ.name] && !p.types[lastSegment(typ.name)] && p.unresolved != nil {
+		p.unresolved[typ.name] = true
+	}
+	return shared.Input{Type: shared.TypeAddress + typ.suffix}
+}
+
+// structFields returns fields of the struct type, or nil if the type is not a known struct.
+func (p *parser) structFields(typ *typeExpr) []param {
+	if fields, ok := p.structs[typ.name]; ok {
+		return fields
+	}
+	return p.structs[lastSegment(typ.name)]
+}
+
+// lastSegment returns the last segment of a qualified name, e.g. "Slot0" for "IPool.Slot0".
+func lastSegment(name string) string {
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '.' {
+			return name[i+1:]
+		}
+	}
+	return name
+}
@@ -0,0 +1,200 @@
+package solidity
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestParse(t *testing.T) {
+	src, err := os.ReadFile("testdata/token.sol")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	contracts, err := Parse(src)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	kinds := map[string]Kind{}
+	for _, contract := range contracts {
+		kinds[contract.Name] = contract.Kind
+	}
+	assert.Equal(t, map[string]Kind{"IERC20Metadata": KindInterface, "Ownable": KindAbstract, "Token": KindContract}, kinds)
+
+	token := Find(contracts, "")
+	if !assert.NotNil(t, token) {
+		return
+	}
+	assert.Equal(t, "Token", token.Name)
+	assert.Equal(t, []string{"Ownable", "IERC20Metadata"}, token.Bases)
+
+	functions := map[string]shared.Function{}
+	for _, fn := range token.Functions {
+		functions[fn.Signature()] = fn
+	}
+
+	assert.ElementsMatch(t, []string{
+		"name()", "symbol()", "decimals()", "totalSupply()", "balanceOf(address)", "allowance(address,address)",
+		"locks(address)", "status()", "prices(uint256)", "transfer(address,uint256)", "approve(address,uint256)",
+		"transferFrom(address,address,uint256)", "lock((uint256,uint64,address[]),uint8,address)",
+		"transferOwnership(address)", "owner()",
+	}, keys(functions))
+
+	assert.Equal(t, shared.StateMutabilityView, functions["balanceOf(address)"].StateMutability)
+	assert.Equal(t, shared.StateMutabilityNonPayable, functions["transfer(address,uint256)"].StateMutability)
+	assert.Equal(t, shared.StateMutabilityPayable, functions["lock((uint256,uint64,address[]),uint8,address)"].StateMutability)
+	assert.Equal(t, []shared.Output{{Type: shared.TypeBool}}, functions["transfer(address,uint256)"].Outputs)
+	assert.Equal(t, []shared.Output{{Type: shared.TypeString}}, functions["name()"].Outputs)
+	assert.Equal(t, []shared.Output{{Type: shared.TypeUint128}}, functions["prices(uint256)"].Outputs)
+
+	// Struct getters return members other than arrays and mappings, named after them.
+	assert.Equal(t, []shared.Output{{Name: "amount", Type: shared.TypeUint256}, {Name: "until", Type: "uint64"}}, functions["locks(address)"].Outputs)
+
+	// Parameters are named as declared.
+	assert.Equal(t, []shared.Input{{Name: "to", Type: shared.TypeAddress}, {Name: "amount", Type: shared.TypeUint256}}, functions["transfer(address,uint256)"].Inputs)
+
+	lock := functions["lock((uint256,uint64,address[]),uint8,address)"]
+	assert.Equal(t, "amount", lock.Inputs[0].Components[0].Name)
+	assert.Equal(t, shared.TypeAddressArray, lock.Inputs[0].Components[2].Type)
+
+	events := map[string]shared.Event{}
+	for _, event := range token.Events {
+		events[event.Signature()] = event
+	}
+	assert.ElementsMatch(t, []string{
+		"Transfer(address,address,uint256)", "Approval(address,address,uint256)", "OwnershipTransferred(address,address)",
+	}, keys(events))
+	assert.True(t, events["Transfer(address,address,uint256)"].Inputs[0].Indexed)
+	assert.False(t, events["Transfer(address,address,uint256)"].Inputs[2].Indexed)
+	assert.Equal(t, "value", events["Transfer(address,address,uint256)"].Inputs[2].Name)
+
+	errors := make([]string, 0)
+	for _, e := range token.Errors {
+		errors = append(errors, e.Signature())
+		if e.Name == "InsufficientBalance" {
+			assert.Equal(t, []shared.Input{{Name: "available", Type: shared.TypeUint256}, {Name: "required", Type: shared.TypeUint256}}, e.Inputs)
+		}
+	}
+	assert.ElementsMatch(t, []string{"InsufficientBalance(uint256,uint256)", "Unauthorized(address)"}, errors)
+
+	// Members point to their definitions, including state variables and members inherited from bases.
+	assert.Equal(t, 34, token.Line)
+	assert.Equal(t, 65, token.MemberLine("function", "transfer(address,uint256)"))
+	assert.Equal(t, 91, token.MemberLine("function", "transferOwnership(address)"))
+	assert.Equal(t, 48, token.MemberLine("function", "totalSupply()"))
+	assert.Equal(t, 17, token.MemberLine("function", "owner()"))
+	assert.Equal(t, 56, token.MemberLine("event", "Transfer(address,address,uint256)"))
+	assert.Equal(t, 19, token.MemberLine("event", "OwnershipTransferred(address,address)"))
+	assert.Equal(t, 0, token.MemberLine("function", "mint(address,uint256)"))
+}
+
+func TestNewContractMatcherFromSource(t *testing.T) {
+	src := []byte(`
+		pragma solidity ^0.6.0;
+		abstract contract Vault {
+			function balanceOf(address who) public view virtual returns (uint);
+			fallback() external payable {}
+		}
+		library Math { function max(uint a, uint b) public pure returns (uint) { return a > b ? a : b; } }
+	`)
+
+	matcher, err := NewContractMatcherFromSource("", src)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Vault", matcher.Name)
+	if assert.Len(t, matcher.Functions, 1) {
+		assert.Equal(t, "balanceOf(address)", matcher.Functions[0].Signature())
+		assert.Equal(t, shared.StateMutabilityView, matcher.Functions[0].StateMutability)
+		assert.Equal(t, shared.TypeUint256, matcher.Functions[0].Outputs[0].Type)
+	}
+
+	matcher, err = NewContractMatcherFromSource("Math", src)
+	assert.NoError(t, err)
+	assert.Equal(t, "Math", matcher.Name)
+
+	_, err = NewContractMatcherFromSource("Missing", src)
+	assert.Error(t, err)
+
+	_, err = NewContractMatcherFromSource("", []byte("pragma solidity ^0.8.0;"))
+	assert.Error(t, err)
+
+	_, err = NewContractMatcherFromSource("", []byte("contract Broken { function f() external {"))
+	assert.Error(t, err)
+
+	_, err = NewContractMatcherFromSource("", []byte("/* unterminated"))
+	assert.Error(t, err)
+
+	// Syntax preceding Solidity 0.5, such as constant functions, is not supported by the grammar.
+	_, err = NewContractMatcherFromSource("", []byte("contract Legacy { function balanceOf(address who) constant returns (uint); }"))
+	assert.Error(t, err)
+}
+
+func TestParseWithOptions(t *testing.T) {
+	src := []byte(`
+		import {Currency} from "v4-core/src/types/Currency.sol";
+		import {BalanceDelta} from "v4-core/src/types/BalanceDelta.sol";
+		type Price is uint128;
+		contract Hook {
+			function settle(Currency currency, BalanceDelta delta, Price price) external returns (BalanceDelta) {}
+		}
+	`)
+
+	// Imported user-defined value types are unknown without options, so they are taken for contracts.
+	contracts, err := Parse(src)
+	if assert.NoError(t, err) && assert.Len(t, contracts, 1) {
+		assert.Equal(t, "settle(address,address,uint128)", contracts[0].Functions[0].Signature())
+		assert.Equal(t, []string{"BalanceDelta", "Currency"}, contracts[0].Unresolved)
+	}
+
+	// Types declared within the source take precedence over the options.
+	aliases := shared.TypeAliases{"Currency": shared.TypeAddress, "BalanceDelta": shared.TypeInt256, "Price": shared.TypeUint256}
+	contracts, err = ParseWithOptions(src, Options{TypeAliases: aliases})
+	if assert.NoError(t, err) && assert.Len(t, contracts, 1) {
+		fn := contracts[0].Functions[0]
+		assert.Equal(t, "settle(address,int256,uint128)", fn.Signature())
+		assert.Equal(t, []shared.Output{{Type: shared.TypeInt256}}, fn.Outputs)
+	}
+}
+
+func TestParseUnresolved(t *testing.T) {
+	src := []byte(`
+		import {IERC20} from "./IERC20.sol";
+		import {PoolKey} from "./PoolKey.sol";
+		interface IVault { function asset() external view returns (IERC20); }
+		contract Vault is IVault {
+			function asset() external view returns (IERC20) {}
+			function vault() external view returns (IVault) {}
+			function initialize(PoolKey calldata key, IERC20[] calldata tokens) external {}
+		}
+	`)
+
+	contracts, err := Parse(src)
+	if !assert.NoError(t, err) || !assert.Len(t, contracts, 2) {
+		return
+	}
+
+	// Imported types are taken for contracts, even though PoolKey is a struct, while contracts defined within
+	// the source are resolved.
+	vault := contracts[1]
+	assert.Equal(t, []string{"IERC20", "PoolKey"}, vault.Unresolved)
+	assert.Equal(t, "initialize(address,address[])", vault.Functions[2].Signature())
+	assert.Equal(t, []string{"IERC20"}, contracts[0].Unresolved)
+
+	contracts, err = Parse([]byte("contract Empty { function f(uint256 a) external {} }"))
+	if assert.NoError(t, err) && assert.Len(t, contracts, 1) {
+		assert.Empty(t, contracts[0].Unresolved)
+	}
+}
+
+func keys[T any](m map[string]T) []string {
+	toReturn := make([]string, 0, len(m))
+	for k := range m {
+		toReturn = append(toReturn, k)
+	}
+	return toReturn
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import {IERC20} from "./IERC20.sol";
+
+/// @dev File-level error, only part of the contracts that use it.
+error Unauthorized(address account);
+error NeverUsed();
+
+interface IERC20Metadata {
+    function name() external view returns (string memory);
+    function symbol() external view returns (string memory);
+    function decimals() external view returns (uint8);
+}
+
+abstract contract Ownable {
+    address public owner;
+
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    modifier onlyOwner() {
+        if (msg.sender != owner) revert Unauthorized(msg.sender);
+        _;
+    }
+
+    function transferOwnership(address newOwner) public virtual onlyOwner {
+        emit OwnershipTransferred(owner, newOwner);
+        owner = newOwner;
+    }
+
+    function _checkOwner() internal view {}
+}
+
+contract Token is Ownable, IERC20Metadata {
+    type Price is uint128;
+
+    enum Status { Active, Paused }
+
+    struct Lock {
+        uint256 amount;
+        uint64 until;
+        address[] beneficiaries;
+    }
+
+    string public override name = "Token";
+    string public override symbol = "TKN";
+    uint8 public constant decimals = 18;
+    uint256 public totalSupply;
+    mapping(address => uint256) public balanceOf;
+    mapping(address owner => mapping(address spender => uint256)) public allowance;
+    mapping(address => Lock) public locks;
+    Status public status;
+    Price[] public prices;
+    uint256 private _secret;
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    error InsufficientBalance(uint256 available, uint256 required);
+
+    constructor() payable {
+        owner = msg.sender;
+    }
+
+    function transfer(address to, uint256 amount) external returns (bool) {
+        if (balanceOf[msg.sender] < amount) {
+            revert InsufficientBalance({available: balanceOf[msg.sender], required: amount});
+        }
+        balanceOf[msg.sender] -= amount;
+        balanceOf[to] += amount;
+        emit Transfer(msg.sender, to, amount);
+        return true;
+    }
+
+    function approve(address spender, uint256 amount) external returns (bool) {
+        allowance[msg.sender][spender] = amount;
+        emit Approval(msg.sender, spender, amount);
+        return true;
+    }
+
+    function transferFrom(address from, address payable to, uint amount) external returns (bool) {
+        /* Simplified, no allowance checks. */
+        balanceOf[from] -= amount;
+        balanceOf[to] += amount;
+        emit Transfer(from, to, amount);
+        return true;
+    }
+
+    function lock(Lock calldata newLock, Status newStatus, IERC20 token) external payable onlyOwner {}
+
+    function transferOwnership(address newOwner) public override(Ownable) onlyOwner {
+        super.transferOwnership(newOwner);
+    }
+
+    function _mint(address to, uint256 amount) internal {}
+
+    receive() external payable {}
+}
@@ -8,33 +8,73 @@ import (
 
 // Constants representing various Ethereum standards and EIPs.
 const (
-	ERC20     shared.Standard = "ERC20"     // ERC-20 Token Standard.
-	ERC721    shared.Standard = "ERC721"    // ERC-721 Non-Fungible Token Standard.
-	ERC1822   shared.Standard = "ERC1822"   // ERC-1822 Universal Proxy Standard (UPS).
-	ERC1820   shared.Standard = "ERC1820"   // ERC-1820 Pseudo-introspection Registry Contract.
-	ERC777    shared.Standard = "ERC777"    // ERC-777 Token Standard.
-	ERC1155   shared.Standard = "ERC1155"   // ERC-1155 Multi Token Standard.
-	ERC1337   shared.Standard = "ERC1337"   // ERC-1337 Subscription Standard.
-	ERC1400   shared.Standard = "ERC1400"   // ERC-1400 Security Token Standard.
-	ERC1410   shared.Standard = "ERC1410"   // ERC-1410 Partially Fungible Token Standard.
-	ERC165    shared.Standard = "ERC165"    // ERC-165 Standard Interface Detection.
-	ERC820    shared.Standard = "ERC820"    // ERC-820 Registry Standard.
-	ERC1014   shared.Standard = "ERC1014"   // ERC-1014 Create2 Standard.
-	ERC1948   shared.Standard = "ERC1948"   // ERC-1948 Non-Fungible Data Token Standard.
-	ERC1967   shared.Standard = "ERC1967"   // ERC-1967 Proxy Storage Slots Standard.
-	ERC2309   shared.Standard = "ERC2309"   // ERC-2309 Consecutive Transfer Standard.
-	ERC2535   shared.Standard = "ERC2535"   // ERC-2535 Diamond Standard.
-	ERC2771   shared.Standard = "ERC2771"   // ERC-2771 Meta Transactions Standard.
-	ERC2917   shared.Standard = "ERC2917"   // ERC-2917 Interest-Bearing Tokens Standard.
-	ERC3156   shared.Standard = "ERC3156"   // ERC-3156 Flash Loans Standard.
-	ERC3664   shared.Standard = "ERC3664"   // ERC-3664 BitWords Standard.
-	UNISWAPV2 shared.Standard = "UNISWAPV2" // Uniswap V2 Core.
-	OZOWNABLE shared.Standard = "OZOWNABLE" // OpenZeppelin Ownable.
+	ERC20           shared.Standard = "ERC20"           // ERC-20 Token Standard.
+	ERC721          shared.Standard = "ERC721"          // ERC-721 Non-Fungible Token Standard.
+	ERC1822         shared.Standard = "ERC1822"         // ERC-1822 Universal Proxy Standard (UPS).
+	ERC1820         shared.Standard = "ERC1820"         // ERC-1820 Pseudo-introspection Registry Contract.
+	ERC777          shared.Standard = "ERC777"          // ERC-777 Token Standard.
+	ERC1155         shared.Standard = "ERC1155"         // ERC-1155 Multi Token Standard.
+	ERC1337         shared.Standard = "ERC1337"         // ERC-1337 Subscription Standard.
+	ERC1400         shared.Standard = "ERC1400"         // ERC-1400 Security Token Standard.
+	ERC1410         shared.Standard = "ERC1410"         // ERC-1410 Partially Fungible Token Standard.
+	ERC165          shared.Standard = "ERC165"          // ERC-165 Standard Interface Detection.
+	ERC820          shared.Standard = "ERC820"          // ERC-820 Registry Standard.
+	ERC1014         shared.Standard = "ERC1014"         // ERC-1014 Create2 Standard.
+	ERC1948         shared.Standard = "ERC1948"         // ERC-1948 Non-Fungible Data Token Standard.
+	ERC1967         shared.Standard = "ERC1967"         // ERC-1967 Proxy Storage Slots Standard.
+	ERC2309         shared.Standard = "ERC2309"         // ERC-2309 Consecutive Transfer Standard.
+	ERC2535         shared.Standard = "ERC2535"         // ERC-2535 Diamond Standard.
+	ERC2771         shared.Standard = "ERC2771"         // ERC-2771 Meta Transactions Standard.
+	ERC2917         shared.Standard = "ERC2917"         // ERC-2917 Interest-Bearing Tokens Standard.
+	ERC3156         shared.Standard = "ERC3156"         // ERC-3156 Flash Loans Standard (Lender).
+	ERC3156BORROWER shared.Standard = "ERC3156BORROWER" // ERC-3156 Flash Loans Standard (Borrower).
+	ERC3664         shared.Standard = "ERC3664"         // ERC-3664 BitWords Standard.
+	UNISWAPV2       shared.Standard = "UNISWAPV2"       // Uniswap V2 Core.
+	OZOWNABLE       shared.Standard = "OZOWNABLE"       // OpenZeppelin Ownable.
+
+	UNISWAPV2FACTORY         shared.Standard = "UNISWAPV2FACTORY"         // Uniswap V2 Factory.
+	UNISWAPV2ROUTER          shared.Standard = "UNISWAPV2ROUTER"          // Uniswap V2 Router (Router02).
+	UNISWAPV3POOL            shared.Standard = "UNISWAPV3POOL"            // Uniswap V3 Pool.
+	UNISWAPV3FACTORY         shared.Standard = "UNISWAPV3FACTORY"         // Uniswap V3 Factory.
+	UNISWAPV3POSITIONMANAGER shared.Standard = "UNISWAPV3POSITIONMANAGER" // Uniswap V3 Nonfungible Position Manager.
+	UNISWAPV3ROUTER          shared.Standard = "UNISWAPV3ROUTER"          // Uniswap V3 Swap Router.
+	UNISWAPV4HOOKS           shared.Standard = "UNISWAPV4HOOKS"           // Uniswap V4 Hooks.
+
+	ERC20ERRORS   shared.Standard = "ERC20ERRORS"   // ERC-6093 Custom Errors for ERC-20 Tokens.
+	ERC721ERRORS  shared.Standard = "ERC721ERRORS"  // ERC-6093 Custom Errors for ERC-721 Tokens.
+	ERC1155ERRORS shared.Standard = "ERC1155ERRORS" // ERC-6093 Custom Errors for ERC-1155 Tokens.
+
+	ERC4337ENTRYPOINT shared.Standard = "ERC4337ENTRYPOINT" // ERC-4337 Account Abstraction EntryPoint (v0.7).
+	ERC4337ACCOUNT    shared.Standard = "ERC4337ACCOUNT"    // ERC-4337 Account (IAccount).
+	ERC4337PAYMASTER  shared.Standard = "ERC4337PAYMASTER"  // ERC-4337 Paymaster (IPaymaster).
+	ERC4337AGGREGATOR shared.Standard = "ERC4337AGGREGATOR" // ERC-4337 Signature Aggregator (IAggregator).
+	ERC7579ACCOUNT    shared.Standard = "ERC7579ACCOUNT"    // ERC-7579 Minimal Modular Smart Account.
+	ERC7579MODULE     shared.Standard = "ERC7579MODULE"     // ERC-7579 Module, e.g. executor or fallback handler.
+	ERC7579VALIDATOR  shared.Standard = "ERC7579VALIDATOR"  // ERC-7579 Validator Module.
+	ERC7579HOOK       shared.Standard = "ERC7579HOOK"       // ERC-7579 Hook Module.
+
+	ERC2981 shared.Standard = "ERC2981" // ERC-2981 NFT Royalty Standard.
+	ERC4906 shared.Standard = "ERC4906" // ERC-4906 Metadata Update Extension.
+	ERC4907 shared.Standard = "ERC4907" // ERC-4907 Rental NFT Extension.
+	ERC5192 shared.Standard = "ERC5192" // ERC-5192 Minimal Soulbound NFTs.
+	ERC721A shared.Standard = "ERC721A" // ERC721A Gas Efficient Batch Minting (Azuki).
+
+	AAVEV2POOL       shared.Standard = "AAVEV2POOL"       // Aave V2 Lending Pool.
+	AAVEV3POOL       shared.Standard = "AAVEV3POOL"       // Aave V3 Pool.
+	COMPOUNDV2CTOKEN shared.Standard = "COMPOUNDV2CTOKEN" // Compound V2 cToken.
+	COMPOUNDV3COMET  shared.Standard = "COMPOUNDV3COMET"  // Compound V3 Comet.
+
+	ERC7281       shared.Standard = "ERC7281"       // ERC-7281 Sovereign Bridged Token (xERC20).
+	LAYERZEROOFT  shared.Standard = "LAYERZEROOFT"  // LayerZero V2 Omnichain Fungible Token (OFT).
+	WORMHOLENTT   shared.Standard = "WORMHOLENTT"   // Wormhole Native Token Transfers (NTT) Manager.
+	CCIPTOKENPOOL shared.Standard = "CCIPTOKENPOOL" // Chainlink CCIP Token Pool.
 )
 
+// GetContractByStandard returns the contract of the provided standard. The contract is built from a copy of the
+// definition, so callers may modify it without affecting the registry or other callers.
 func GetContractByStandard(standard shared.Standard) (shared.EIP, error) {
-	if standard, ok := standards[standard]; ok {
-		return contracts.NewContract(standard), nil
+	if cs, ok := standards[standard]; ok {
+		return contracts.NewContract(cs.Clone()), nil
 	}
 	return nil, errors.ErrStandardNotFound
 }
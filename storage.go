@@ -2,13 +2,19 @@ package standards
 
 import (
 	"fmt"
-	"github.com/unpackdev/standards/shared"
 	"sort"
+	"strings"
+
+	"github.com/unpackdev/standards/shared"
 )
 
 // storage is a map that holds registered Ethereum standards.
 var storage map[shared.Standard]shared.EIP
 
+// index is an inverted index of registered standards, mapping function and error selectors as well as
+// event topics to the standards that define them. It is populated as standards are registered.
+var index map[string][]shared.Standard
+
 // RegisterStandard registers a new Ethereum standard to the storage.
 // If the standard already exists, it returns an error.
 //
@@ -24,6 +30,8 @@ func RegisterStandard(s shared.Standard, cs shared.EIP) error {
 	}
 
 	storage[s] = cs
+	indexStandard(s, cs)
+	registerVersions(s, cs)
 	return nil
 }
 
@@ -80,3 +88,185 @@ func GetSortedRegisteredStandards() []shared.EIP {
 func StandardsLoaded() bool {
 	return len(storage) > 1
 }
+
+// GetCandidateStandards retrieves registered Ethereum standards that share at least one function, event or error
+// with the provided contract, in a sorted order. Members are compared by their selectors and topics, so only
+// standards that can possibly match the contract are returned, without visiting every registered standard.
+//
+// Parameters:
+// - contract: The contract to look up candidate standards for.
+//
+// Returns:
+// - []EIP: A slice of candidate Ethereum standards.
+func GetCandidateStandards(contract *shared.ContractMatcher) []shared.EIP {
+	seen := make(map[shared.Standard]bool)
+	collect := func(key string) {
+		for _, s := range index[key] {
+			seen[s] = true
+		}
+	}
+
+	for _, fn := range contract.Functions {
+		collect(functionIndexKey(fn))
+	}
+	for _, event := range contract.Events {
+		collect(eventIndexKey(event))
+	}
+	for _, e := range contract.Errors {
+		collect(errorIndexKey(e))
+	}
+
+	eips := make([]shared.EIP, 0, len(seen))
+	for s := range seen {
+		// Index entries may outlive their standard, e.g. once the storage is replaced.
+		if eip, ok := storage[s]; ok {
+			eips = append(eips, eip)
+		}
+	}
+
+	sort.Slice(eips, func(i, j int) bool {
+		return eips[i].GetType().String() < eips[j].GetType().String()
+	})
+
+	return eips
+}
+
+// GetExtensions retrieves registered Ethereum standards that extend the provided parent standard in a sorted
+// order, e.g. ERC2981 or ERC4907 for ERC721.
+//
+// Parameters:
+// - parent: The Ethereum standard type being extended.
+//
+// Returns:
+// - []EIP: A slice of Ethereum standards extending the parent.
+func GetExtensions(parent shared.Standard) []shared.EIP {
+	eips := make([]shared.EIP, 0)
+	for _, eip := range GetSortedRegisteredStandards() {
+		for _, extended := range eip.GetStandard().Extends {
+			if extended == parent {
+				eips = append(eips, eip)
+				break
+			}
+		}
+	}
+	return eips
+}
+
+// StandardFilter holds criteria registered Ethereum standards are filtered by, see FilterStandards.
+// Empty criteria match every standard, while a criterion holding multiple values matches any of them.
+type StandardFilter struct {
+	EIP        int               // Number of the EIP defining the standard.
+	Statuses   []shared.Status   // EIP statuses, e.g. Final.
+	Categories []shared.Category // Categories, e.g. token or defi.
+	Tags       []string          // Tags, e.g. nft. Tags are compared case-insensitively.
+	Author     string            // Case-insensitive part of an author name.
+	Requires   shared.Standard   // Standard required by the standard, e.g. ERC165.
+}
+
+// Matches reports whether the contract standard satisfies all criteria of the filter.
+func (f StandardFilter) Matches(cs shared.ContractStandard) bool {
+	if f.EIP != 0 && cs.EIP != f.EIP {
+		return false
+	}
+	if len(f.Statuses) > 0 && !contains(f.Statuses, cs.Status) {
+		return false
+	}
+	if len(f.Categories) > 0 && !contains(f.Categories, cs.Category) {
+		return false
+	}
+	if f.Requires != "" && !contains(cs.Requires, f.Requires) {
+		return false
+	}
+
+	if len(f.Tags) > 0 {
+		found := false
+		for _, tag := range f.Tags {
+			for _, csTag := range cs.Tags {
+				if strings.EqualFold(tag, csTag) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Author != "" {
+		found := false
+		for _, author := range cs.Authors {
+			if strings.Contains(strings.ToLower(author), strings.ToLower(f.Author)) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterStandards retrieves registered Ethereum standards matching the filter in a sorted order.
+//
+// Parameters:
+// - filter: The criteria standards are filtered by.
+//
+// Returns:
+// - []EIP: A slice of Ethereum standards matching the filter.
+func FilterStandards(filter StandardFilter) []shared.EIP {
+	eips := make([]shared.EIP, 0)
+	for _, eip := range GetSortedRegisteredStandards() {
+		if filter.Matches(eip.GetStandard()) {
+			eips = append(eips, eip)
+		}
+	}
+	return eips
+}
+
+// GetStandardsByCategory retrieves registered Ethereum standards grouped by their category, each group in
+// a sorted order. Standards without a category are grouped under the empty category.
+func GetStandardsByCategory() map[shared.Category][]shared.EIP {
+	toReturn := make(map[shared.Category][]shared.EIP)
+	for _, eip := range GetSortedRegisteredStandards() {
+		category := eip.GetStandard().Category
+		toReturn[category] = append(toReturn[category], eip)
+	}
+	return toReturn
+}
+
+// contains reports whether the value is present in the values.
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// indexStandard adds members of the standard into the inverted index.
+func indexStandard(s shared.Standard, cs shared.EIP) {
+	add := func(key string) {
+		if registered := index[key]; len(registered) > 0 && registered[len(registered)-1] == s {
+			return // Overloaded or duplicated member...
+		}
+		index[key] = append(index[key], s)
+	}
+
+	for _, fn := range cs.GetFunctions() {
+		add(functionIndexKey(fn))
+	}
+	for _, event := range cs.GetEvents() {
+		add(eventIndexKey(event))
+	}
+	for _, e := range cs.GetErrors() {
+		add(errorIndexKey(e))
+	}
+}
+
+// functionIndexKey, eventIndexKey and errorIndexKey build inverted index keys. Keys are namespaced by member
+// kind, as function and error selectors share the same 4-byte space.
+func functionIndexKey(fn shared.Function) string { return "function:" + fn.Selector() }
+func eventIndexKey(event shared.Event) string    { return "event:" + event.Topic() }
+func errorIndexKey(e shared.Error) string        { return "error:" + e.Selector() }
@@ -1,9 +1,11 @@
 package standards
 
 import (
+	"github.com/unpackdev/standards/directory/openzeppelin"
 	"github.com/unpackdev/standards/shared"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	eip_pb "github.com/unpackdev/protos/dist/go/eip"
@@ -26,6 +28,7 @@ func TestEIPStorage(t *testing.T) {
 		standard       shared.EIP
 		expectedExists bool
 		isStagnant     bool
+		eventsOnly     bool
 		expectedError  string
 	}{
 		{
@@ -93,6 +96,7 @@ func TestEIPStorage(t *testing.T) {
 				return standard
 			}(),
 			expectedExists: true,
+			eventsOnly:     true,
 			expectedError:  "standard ERC1967 already exists",
 		},
 	}
@@ -112,8 +116,8 @@ func TestEIPStorage(t *testing.T) {
 			// Test GetUrl
 			assert.NotEmpty(t, tt.standard.GetUrl())
 
-			// Test GetFunctions
-			assert.NotEmpty(t, tt.standard.GetFunctions())
+			// Test GetFunctions, proxy slot standards such as ERC-1967 only declare events
+			assert.Equal(t, tt.eventsOnly, len(tt.standard.GetFunctions()) == 0)
 
 			// Test GetEvents
 			assert.NotEmpty(t, tt.standard.GetEvents())
@@ -163,3 +167,527 @@ func TestEIPStorage(t *testing.T) {
 		})
 	}
 }
+
+// TestStandardDefinitions checks every standard of the directory against its own members, so definitions whose
+// ABI, functions, events and errors disagree are caught once for the whole registry.
+func TestStandardDefinitions(t *testing.T) {
+	for s, cs := range standards {
+		t.Run(s.String(), func(t *testing.T) {
+			standard, err := GetContractByStandard(s)
+			assert.NoError(t, err)
+
+			assert.NotEmpty(t, cs.Name)
+			assert.NotEmpty(t, cs.Url)
+			assert.NotEmpty(t, cs.ABI)
+
+			// ERC1820 ABI predates the final registry interface, so its mutability is not resolved.
+			if s != ERC1820 {
+				for _, fn := range standard.GetFunctions() {
+					assert.NotEmpty(t, fn.StateMutability, "function %s mutability not resolved from abi", fn.Signature())
+				}
+			}
+
+			// Contract exposing exactly the standard members must be a perfect match.
+			discovery, found := standard.ConfidenceCheck(&shared.ContractMatcher{
+				Name:      cs.Name,
+				Functions: standard.GetFunctions(),
+				Events:    standard.GetEvents(),
+				Errors:    standard.GetErrors(),
+			})
+			assert.True(t, found)
+			assert.Equal(t, shared.PerfectConfidence, discovery.Confidence)
+			assert.Equal(t, standard.TokenCount(), discovery.DiscoveredTokens)
+			assert.Equal(t, cs.Category, discovery.Category)
+		})
+	}
+}
+
+func TestGeneratedStandards(t *testing.T) {
+	generated := openzeppelin.Standards()
+	assert.Contains(t, generated, OZOWNABLE)
+
+	for s, definition := range generated {
+		t.Run(s.String(), func(t *testing.T) {
+			cs, ok := standards[s]
+			if !assert.True(t, ok, "generated standard %s is not defined in the directory", s) {
+				return
+			}
+
+			// Generated members replace the hand-written ones, while the directory metadata is kept.
+			assert.Equal(t, definition.ABI, cs.ABI)
+			assert.Equal(t, definition.Events, cs.Events)
+			assert.Equal(t, definition.Errors, cs.Errors)
+			assert.Equal(t, s, cs.Type)
+			assert.NotEmpty(t, cs.Name)
+
+			// Directory functions additionally hold the state mutability resolved out of the ABI.
+			if !assert.Len(t, cs.Functions, len(definition.Functions)) {
+				return
+			}
+			for i, fn := range definition.Functions {
+				assert.Equal(t, fn.Signature(), cs.Functions[i].Signature())
+				assert.NotEmpty(t, cs.Functions[i].StateMutability)
+			}
+		})
+	}
+
+	ownable, err := GetContractByStandard(OZOWNABLE)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "v5", ownable.GetStandard().Version)
+		assert.Len(t, ownable.GetStandard().Versions, 1)
+	}
+}
+
+func TestMergeGenerated(t *testing.T) {
+	standard := standards[ERC1822]
+
+	// Generated definitions covering every hand-written member replace them, keeping the metadata.
+	generated := shared.ContractStandard{
+		ABI:       `[]`,
+		Functions: append([]shared.Function{shared.NewFunction("proxiableUUID", nil, []shared.Output{{Type: shared.TypeBytes32}})}, standard.Functions...),
+		Events:    standard.Events,
+	}
+	merged, err := mergeGenerated(standard, generated)
+	if assert.NoError(t, err) {
+		assert.Equal(t, generated.ABI, merged.ABI)
+		assert.Len(t, merged.Functions, len(standard.Functions)+1)
+		assert.Equal(t, standard.Name, merged.Name)
+	}
+
+	// Generated definitions dropping hand-written members are rejected.
+	generated.Functions = generated.Functions[:1]
+	_, err = mergeGenerated(standard, generated)
+	assert.ErrorContains(t, err, "generated definition of standard ERC1822 drops function getImplementation()")
+}
+
+func TestTokenEventDefinitions(t *testing.T) {
+	tests := []struct {
+		standard shared.Standard
+		event    string
+		topic    string
+		indexed  []bool
+	}{
+		// ERC-721 indexes tokenId, unlike the ERC-20 events sharing the same topic.
+		{standard: ERC721, event: "Transfer", topic: "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", indexed: []bool{true, true, true}},
+		{standard: ERC721, event: "Approval", topic: "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", indexed: []bool{true, true, true}},
+		{standard: ERC20, event: "Transfer", topic: "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", indexed: []bool{true, true, false}},
+		{standard: ERC1155, event: "TransferBatch", topic: "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb", indexed: []bool{true, true, true, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.standard.String()+"/"+tt.event, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, event := range standard.GetEvents() {
+				if event.Name != tt.event {
+					continue
+				}
+
+				indexed := make([]bool, 0, len(event.Inputs))
+				for _, input := range event.Inputs {
+					indexed = append(indexed, input.Indexed)
+				}
+				assert.Equal(t, tt.topic, event.Topic())
+				assert.Equal(t, tt.indexed, indexed)
+				return
+			}
+			t.Errorf("event %s not defined", tt.event)
+		})
+	}
+}
+
+func TestUniswapStandards(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard shared.Standard
+	}{
+		{name: "Uniswap V2 Pair", standard: UNISWAPV2},
+		{name: "Uniswap V2 Factory", standard: UNISWAPV2FACTORY},
+		{name: "Uniswap V2 Router", standard: UNISWAPV2ROUTER},
+		{name: "Uniswap V3 Pool", standard: UNISWAPV3POOL},
+		{name: "Uniswap V3 Factory", standard: UNISWAPV3FACTORY},
+		{name: "Uniswap V3 Position Manager", standard: UNISWAPV3POSITIONMANAGER},
+		{name: "Uniswap V3 Swap Router", standard: UNISWAPV3ROUTER},
+		{name: "Uniswap V4 Hooks", standard: UNISWAPV4HOOKS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			assert.NoError(t, err)
+			assert.NotNil(t, standard)
+
+			// Uniswap standards are not part of the protobuf enum and must resolve to unknown.
+			assert.Equal(t, eip_pb.Standard_UNKNOWN, standard.ToProto().GetType())
+		})
+	}
+}
+
+func TestUniswapStandardsSelectors(t *testing.T) {
+	// Selectors of the deployed Uniswap contracts, as listed on Etherscan.
+	tests := map[shared.Standard][]string{
+		UNISWAPV2:                {"0x0902f1ac", "0x022c0d9f", "0x6a627842", "0x89afcb44", "0xbc25cf77", "0xfff6cae9"},
+		UNISWAPV2ROUTER:          {"0xe8e33700", "0x38ed1739", "0x7ff36ab5", "0x18cbafe5", "0xd06ca61f"},
+		UNISWAPV3POOL:            {"0x3850c7bd", "0x128acb08", "0x3c8a7d8d", "0x4f1eb3d8", "0x490e6cbc"},
+		UNISWAPV3ROUTER:          {"0x414bf389", "0xc04b8d59", "0xdb3e2198", "0xf28c0498"},
+		UNISWAPV3POSITIONMANAGER: {"0x99fbab88", "0x88316456", "0x219f5d17", "0x0c49ccbe", "0xfc6f7865"},
+	}
+
+	for s, selectors := range tests {
+		t.Run(s.String(), func(t *testing.T) {
+			standard, err := GetContractByStandard(s)
+			assert.NoError(t, err)
+
+			declared := make([]string, 0, len(standard.GetFunctions()))
+			for _, fn := range standard.GetFunctions() {
+				declared = append(declared, fn.Selector())
+			}
+			assert.Subset(t, declared, selectors)
+		})
+	}
+
+	// Struct parameters of the position manager ABI match the tuple definitions regardless of component names.
+	contract, err := shared.NewContractMatcherFromABI("NonfungiblePositionManager", []byte(`[{"inputs":[{"components":[{"internalType":"address","name":"token0","type":"address"},{"internalType":"address","name":"token1","type":"address"},{"internalType":"uint24","name":"fee","type":"uint24"},{"internalType":"int24","name":"tickLower","type":"int24"},{"internalType":"int24","name":"tickUpper","type":"int24"},{"internalType":"uint256","name":"amount0Desired","type":"uint256"},{"internalType":"uint256","name":"amount1Desired","type":"uint256"},{"internalType":"uint256","name":"amount0Min","type":"uint256"},{"internalType":"uint256","name":"amount1Min","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"internalType":"struct INonfungiblePositionManager.MintParams","name":"params","type":"tuple"}],"name":"mint","outputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"uint128","name":"liquidity","type":"uint128"},{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint128","name":"amount0Max","type":"uint128"},{"internalType":"uint128","name":"amount1Max","type":"uint128"}],"internalType":"struct INonfungiblePositionManager.CollectParams","name":"params","type":"tuple"}],"name":"collect","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"payable","type":"function"}]`))
+	assert.NoError(t, err)
+
+	standard, err := GetContractByStandard(UNISWAPV3POSITIONMANAGER)
+	assert.NoError(t, err)
+
+	discovery, found := standard.ConfidenceCheck(contract)
+	assert.True(t, found)
+
+	matched := make(map[string]bool)
+	for _, fn := range discovery.Contract.Functions {
+		matched[fn.Name] = fn.Matched
+	}
+	assert.Equal(t, map[string]bool{
+		"factory": false, "WETH9": false, "positions": false, "createAndInitializePoolIfNecessary": false,
+		"mint": true, "increaseLiquidity": false, "decreaseLiquidity": false, "collect": true, "burn": false,
+		"refundETH": false, "sweepToken": false, "unwrapWETH9": false,
+	}, matched)
+}
+
+func TestAccountAbstractionStandards(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard shared.Standard
+	}{
+		{name: "ERC-4337 EntryPoint", standard: ERC4337ENTRYPOINT},
+		{name: "ERC-4337 Account", standard: ERC4337ACCOUNT},
+		{name: "ERC-4337 Paymaster", standard: ERC4337PAYMASTER},
+		{name: "ERC-4337 Aggregator", standard: ERC4337AGGREGATOR},
+		{name: "ERC-7579 Account", standard: ERC7579ACCOUNT},
+		{name: "ERC-7579 Module", standard: ERC7579MODULE},
+		{name: "ERC-7579 Validator", standard: ERC7579VALIDATOR},
+		{name: "ERC-7579 Hook", standard: ERC7579HOOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			assert.NoError(t, err)
+			assert.NotNil(t, standard)
+
+			assert.Equal(t, shared.CategoryAccount, standard.GetStandard().Category)
+			assert.NotEqual(t, AccountRoleNone, GetAccountRole(tt.standard))
+		})
+	}
+}
+
+func TestUserOperationTuple(t *testing.T) {
+	standard, err := GetContractByStandard(ERC4337ACCOUNT)
+	assert.NoError(t, err)
+
+	fn := standard.GetFunctions()[0]
+	assert.Equal(t, "validateUserOp((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes),bytes32,uint256)", fn.Signature())
+	assert.Equal(t, "0x19822f7c", fn.Selector())
+}
+
+func TestNFTExtensionStandards(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard shared.Standard
+		extends  []shared.Standard
+		proto    eip_pb.Standard
+	}{
+		{name: "ERC-2309 Consecutive Transfer", standard: ERC2309, extends: []shared.Standard{ERC721}, proto: eip_pb.Standard_ERC2309},
+		{name: "ERC-2981 Royalties", standard: ERC2981, extends: []shared.Standard{ERC721, ERC1155}},
+		{name: "ERC-4906 Metadata Update", standard: ERC4906, extends: []shared.Standard{ERC721}},
+		{name: "ERC-4907 Rentals", standard: ERC4907, extends: []shared.Standard{ERC721}},
+		{name: "ERC-5192 Soulbound", standard: ERC5192, extends: []shared.Standard{ERC721}},
+		{name: "ERC721A", standard: ERC721A, extends: []shared.Standard{ERC721}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			assert.NoError(t, err)
+			assert.NotNil(t, standard)
+
+			assert.Equal(t, tt.extends, standard.GetStandard().Extends)
+			assert.Equal(t, tt.proto, standard.ToProto().GetType())
+		})
+	}
+}
+
+func TestGetExtensions(t *testing.T) {
+	loadStandards(t)
+
+	types := func(eips []shared.EIP) []shared.Standard {
+		toReturn := make([]shared.Standard, 0, len(eips))
+		for _, eip := range eips {
+			toReturn = append(toReturn, eip.GetType())
+		}
+		return toReturn
+	}
+
+	assert.Equal(t, []shared.Standard{ERC2309, ERC2981, ERC4906, ERC4907, ERC5192, ERC721A}, types(GetExtensions(ERC721)))
+	assert.Equal(t, []shared.Standard{ERC2981}, types(GetExtensions(ERC1155)))
+	assert.Equal(t, []shared.Standard{ERC7281}, types(GetExtensions(ERC20)))
+	assert.Empty(t, GetExtensions(OZOWNABLE))
+}
+
+func TestLendingStandards(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard shared.Standard
+		proto    eip_pb.Standard
+	}{
+		{name: "ERC-3156 Lender", standard: ERC3156, proto: eip_pb.Standard_ERC3156},
+		{name: "ERC-3156 Borrower", standard: ERC3156BORROWER},
+		{name: "Aave V2 Lending Pool", standard: AAVEV2POOL},
+		{name: "Aave V3 Pool", standard: AAVEV3POOL},
+		{name: "Compound V2 cToken", standard: COMPOUNDV2CTOKEN},
+		{name: "Compound V3 Comet", standard: COMPOUNDV3COMET},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			assert.NoError(t, err)
+			assert.NotNil(t, standard)
+
+			assert.Equal(t, tt.proto, standard.ToProto().GetType())
+		})
+	}
+}
+
+func TestBridgingStandards(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard shared.Standard
+	}{
+		{name: "xERC20", standard: ERC7281},
+		{name: "LayerZero OFT", standard: LAYERZEROOFT},
+		{name: "Wormhole NTT", standard: WORMHOLENTT},
+		{name: "CCIP Token Pool", standard: CCIPTOKENPOOL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, err := GetContractByStandard(tt.standard)
+			assert.NoError(t, err)
+			assert.NotNil(t, standard)
+
+			assert.Equal(t, shared.CategoryBridging, standard.GetStandard().Category)
+		})
+	}
+
+	// Discoveries of other standards are tagged with their own category.
+	standard, err := GetContractByStandard(ERC20)
+	assert.NoError(t, err)
+	discovery, _ := standard.ConfidenceCheck(&shared.ContractMatcher{Functions: standard.GetFunctions()})
+	assert.Equal(t, shared.CategoryToken, discovery.Category)
+}
+
+func TestStandardMetadata(t *testing.T) {
+	for standard, cs := range standards {
+		t.Run(standard.String(), func(t *testing.T) {
+			assert.NotEmpty(t, cs.Category, "every standard must be categorised")
+			assert.NotEmpty(t, cs.Tags)
+			assert.NotEmpty(t, cs.Authors)
+
+			// Standards defined by an EIP carry the EIP status and creation date.
+			if cs.EIP != 0 {
+				assert.NotEmpty(t, cs.Status)
+				_, err := time.Parse(time.DateOnly, cs.Created)
+				assert.NoError(t, err)
+			} else {
+				assert.Empty(t, cs.Status)
+			}
+		})
+	}
+
+	eip, err := GetContractByStandard(ERC721)
+	assert.NoError(t, err)
+	standard := eip.GetStandard()
+	assert.Equal(t, 721, standard.EIP)
+	assert.Equal(t, shared.StatusFinal, standard.Status)
+	assert.Equal(t, shared.CategoryToken, standard.Category)
+	assert.Equal(t, []shared.Standard{ERC165}, standard.Requires)
+	assert.Equal(t, "2018-01-24", standard.Created)
+	assert.False(t, eip.IsStagnant())
+	assert.False(t, eip.ToProto().GetStagnant())
+
+	eip, err = GetContractByStandard(ERC1822)
+	assert.NoError(t, err)
+	assert.True(t, eip.IsStagnant())
+	assert.True(t, eip.ToProto().GetStagnant())
+}
+
+func TestFilterStandards(t *testing.T) {
+	loadStandards(t)
+
+	types := func(eips []shared.EIP) []shared.Standard {
+		toReturn := make([]shared.Standard, 0, len(eips))
+		for _, eip := range eips {
+			toReturn = append(toReturn, eip.GetType())
+		}
+		return toReturn
+	}
+
+	tests := []struct {
+		name     string
+		filter   StandardFilter
+		expected []shared.Standard
+	}{
+		{name: "Proxy", filter: StandardFilter{Categories: []shared.Category{shared.CategoryProxy}}, expected: []shared.Standard{ERC1822, ERC1967}},
+		{name: "Stagnant", filter: StandardFilter{Statuses: []shared.Status{shared.StatusStagnant}}, expected: []shared.Standard{ERC1822}},
+		{name: "EIP", filter: StandardFilter{EIP: 6093}, expected: []shared.Standard{ERC1155ERRORS, ERC20ERRORS, ERC721ERRORS}},
+		{name: "Tag", filter: StandardFilter{Tags: []string{"LENDING"}}, expected: []shared.Standard{AAVEV2POOL, AAVEV3POOL, COMPOUNDV2CTOKEN, COMPOUNDV3COMET}},
+		{name: "Author", filter: StandardFilter{Author: "buterin", Categories: []shared.Category{shared.CategoryToken}}, expected: []shared.Standard{ERC20}},
+		{name: "Requires", filter: StandardFilter{Requires: ERC1155}, expected: []shared.Standard{ERC1155ERRORS}},
+		{
+			name:     "DraftOrFinalBridging",
+			filter:   StandardFilter{Statuses: []shared.Status{shared.StatusDraft, shared.StatusFinal}, Categories: []shared.Category{shared.CategoryBridging}},
+			expected: []shared.Standard{ERC7281},
+		},
+		{name: "NoMatch", filter: StandardFilter{Tags: []string{"unknown"}}, expected: []shared.Standard{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, types(FilterStandards(tt.filter)))
+		})
+	}
+
+	assert.Len(t, FilterStandards(StandardFilter{}), len(GetRegisteredStandards()))
+
+	groups := GetStandardsByCategory()
+	assert.Equal(t, []shared.Standard{ERC1822, ERC1967}, types(groups[shared.CategoryProxy]))
+	assert.Equal(t, []shared.Standard{OZOWNABLE}, types(groups[shared.CategoryAccess]))
+	assert.Empty(t, groups[""])
+}
+
+func TestGetCandidateStandards(t *testing.T) {
+	loadStandards(t)
+
+	tests := []struct {
+		name     string
+		contract *shared.ContractMatcher
+		expected []shared.Standard
+	}{
+		{
+			name: "Ownable",
+			contract: &shared.ContractMatcher{
+				Functions: []shared.Function{shared.NewFunction("renounceOwnership", nil, nil)},
+			},
+			expected: []shared.Standard{"OZOWNABLE"},
+		},
+		{
+			name: "Name Only",
+			contract: &shared.ContractMatcher{
+				Functions: []shared.Function{shared.NewFunction("renounceOwnership", []shared.Input{{Type: shared.TypeAddress}}, nil)},
+			},
+			expected: []shared.Standard{},
+		},
+		{
+			name: "Custom Error",
+			contract: &shared.ContractMatcher{
+				Errors: []shared.Error{shared.NewError("OwnableUnauthorizedAccount", []shared.Input{{Type: shared.TypeAddress}})},
+			},
+			expected: []shared.Standard{"OZOWNABLE"},
+		},
+		{
+			name:     "Empty",
+			contract: &shared.ContractMatcher{},
+			expected: []shared.Standard{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := make([]shared.Standard, 0)
+			for _, eip := range GetCandidateStandards(tt.contract) {
+				candidates = append(candidates, eip.GetType())
+			}
+			assert.Equal(t, tt.expected, candidates)
+		})
+	}
+
+	// Standards sharing a transfer event are all candidates, in a sorted order.
+	transfer := standardMatcher(t, "ERC20").Events[0]
+	candidates := GetCandidateStandards(&shared.ContractMatcher{Events: []shared.Event{transfer}})
+	assert.NotEmpty(t, candidates)
+	for i := 1; i < len(candidates); i++ {
+		assert.Less(t, candidates[i-1].GetType().String(), candidates[i].GetType().String())
+	}
+}
+
+func TestReset(t *testing.T) {
+	loadStandards(t)
+	t.Cleanup(reset)
+
+	reset()
+	assert.Empty(t, storage)
+	assert.Empty(t, index)
+	assert.Empty(t, versions)
+
+	// Standards registered after a reset are the only candidates, as their index starts from scratch.
+	eip, err := GetContractByStandard(ERC721)
+	assert.NoError(t, err)
+	assert.NoError(t, RegisterStandard(ERC721, eip))
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	discoveries, err := detector.Detect(mergeMatchers("Collection", standardMatcher(t, ERC721), standardMatcher(t, ERC20)))
+	assert.NoError(t, err)
+	if assert.Len(t, discoveries, 1) {
+		assert.Equal(t, ERC721, discoveries[0].Standard)
+	}
+
+	// Index entries outliving their standard are skipped.
+	storage = make(map[shared.Standard]shared.EIP)
+	assert.Empty(t, GetCandidateStandards(standardMatcher(t, ERC721)))
+}
+
+func TestGetContractByStandardCopy(t *testing.T) {
+	first, err := GetContractByStandard("ERC20")
+	assert.NoError(t, err)
+
+	// Modifying a returned contract leaves the definition seen by other callers intact.
+	first.GetStandard().Functions[0].Inputs = append(first.GetStandard().Functions[0].Inputs, shared.Input{Type: shared.TypeBool})
+	first.GetFunctions()[0].Name = "corrupted"
+	first.GetEvents()[0].Inputs[0].Type = shared.TypeBytes
+
+	second, err := GetContractByStandard("ERC20")
+	assert.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, standards[ERC20].Functions, second.GetFunctions())
+	assert.NotEqual(t, "corrupted", second.GetFunctions()[0].Name)
+	assert.Equal(t, shared.TypeAddress, second.GetEvents()[0].Inputs[0].Type)
+
+	_, err = GetContractByStandard("CORRUPTED")
+	assert.Error(t, err)
+}
+
+func BenchmarkGetContractByStandard(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = GetContractByStandard("ERC20")
+	}
+}
@@ -0,0 +1,132 @@
+package standards
+
+import (
+	"github.com/unpackdev/standards/confidence"
+	"github.com/unpackdev/standards/contracts"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+// versions maps registered standards to definitions of each of their versions, the latest one first.
+// Only standards declaring superseded versions are present.
+var versions map[shared.Standard][]shared.EIP
+
+// registerVersions registers definitions of the standard versions and adds their members into the inverted
+// index, so that contracts following a superseded version remain candidates of the standard.
+func registerVersions(s shared.Standard, cs shared.EIP) {
+	standard := cs.GetStandard()
+	if len(standard.Versions) == 0 {
+		delete(versions, s)
+		return
+	}
+
+	eips := make([]shared.EIP, 0, len(standard.Versions)+1)
+	eips = append(eips, cs)
+	for _, version := range standard.Versions {
+		inherited := standard
+		inherited.Version = version.Version
+		inherited.ABI = version.ABI
+		inherited.Functions = version.Functions
+		inherited.Events = version.Events
+		inherited.Errors = version.Errors
+		inherited.Versions = nil
+		if version.Url != "" {
+			inherited.Url = version.Url
+		}
+
+		eip := contracts.NewContract(inherited)
+		indexStandard(s, eip)
+		eips = append(eips, eip)
+	}
+	versions[s] = eips
+}
+
+// GetVersions retrieves definitions of every version of a registered standard, the latest one first.
+// Standards without superseded versions only return their single definition.
+//
+// Parameters:
+// - s: The Ethereum standard type.
+//
+// Returns:
+// - []EIP: Definitions of the standard versions.
+// - error: An error if the standard is not registered.
+func GetVersions(s shared.Standard) ([]shared.EIP, error) {
+	eip, ok := GetStandard(s)
+	if !ok {
+		return nil, errors.ErrStandardNotFound
+	}
+	if eips, ok := versions[s]; ok {
+		return eips, nil
+	}
+	return []shared.EIP{eip}, nil
+}
+
+// IsOutdated reports whether the discovery matched a superseded version of its standard, e.g. a contract built
+// with OpenZeppelin v4 rather than v5.
+func IsOutdated(discovery shared.Discovery) bool {
+	eips, ok := versions[discovery.Standard]
+	return ok && discovery.Version != eips[0].GetStandard().Version
+}
+
+// resolveVersion checks the contract against every version of the discovered standard and returns the discovery
+// of the best matching one. Versions are ranked by the number of contract members they lack while other versions
+// declare them, e.g. custom errors introduced by OpenZeppelin v5, followed by the confidence points. Ties are
+// resolved in favour of the later version.
+func resolveVersion(s shared.Standard, contract *shared.ContractMatcher, discovery shared.Discovery, opts confidence.Options) shared.Discovery {
+	eips, ok := versions[s]
+	if !ok {
+		return discovery
+	}
+
+	declared := make([]map[string]bool, len(eips))
+	for i, eip := range eips {
+		declared[i] = memberSignatures(eip.GetFunctions(), eip.GetEvents(), eip.GetErrors())
+	}
+
+	members := memberSignatures(contract.Functions, contract.Events, contract.Errors)
+
+	toReturn, best := discovery, -1
+	for i, eip := range eips {
+		candidate := discovery
+		if i > 0 {
+			var found bool
+			if candidate, found = confidence.ConfidenceCheckWithOptions(eip, contract, opts); !found {
+				continue
+			}
+		}
+
+		foreign := 0
+		for member := range members {
+			if declared[i][member] {
+				continue
+			}
+			for j := range eips {
+				if j != i && declared[j][member] {
+					foreign++
+					break
+				}
+			}
+		}
+
+		if best < 0 || foreign < best || (foreign == best && candidate.ConfidencePoints > toReturn.ConfidencePoints) {
+			toReturn, best = candidate, foreign
+		}
+	}
+
+	return toReturn
+}
+
+// memberSignatures returns the set of signatures of the members, namespaced by their kind.
+func memberSignatures(functions []shared.Function, events []shared.Event, errs []shared.Error) map[string]bool {
+	toReturn := make(map[string]bool, len(functions)+len(events)+len(errs))
+	for _, fn := range functions {
+		toReturn["function:"+fn.Signature()] = true
+	}
+	for _, event := range events {
+		toReturn["event:"+event.Signature()] = true
+	}
+	for _, e := range errs {
+		toReturn["error:"+e.Signature()] = true
+	}
+	return toReturn
+}
@@ -0,0 +1,93 @@
+package standards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unpackdev/standards/errors"
+	"github.com/unpackdev/standards/shared"
+)
+
+func TestGetVersions(t *testing.T) {
+	loadStandards(t)
+
+	eips, err := GetVersions(OZOWNABLE)
+	assert.NoError(t, err)
+	if assert.Len(t, eips, 2) {
+		assert.Equal(t, "v5", eips[0].GetStandard().Version)
+		assert.Equal(t, "v4", eips[1].GetStandard().Version)
+
+		// Versions inherit metadata of the standard, apart from their own members and URL.
+		v4 := eips[1].GetStandard()
+		assert.Equal(t, OZOWNABLE, v4.Type)
+		assert.Equal(t, shared.CategoryAccess, v4.Category)
+		assert.Contains(t, v4.Url, "4.x")
+		assert.Empty(t, v4.Errors)
+		assert.Empty(t, v4.Versions)
+		assert.Equal(t, shared.StateMutabilityView, v4.Functions[0].StateMutability)
+	}
+
+	eips, err = GetVersions(ERC20)
+	assert.NoError(t, err)
+	assert.Len(t, eips, 1)
+
+	_, err = GetVersions(shared.Standard("UNKNOWN"))
+	assert.ErrorIs(t, err, errors.ErrStandardNotFound)
+}
+
+func TestDetectorDetectVersion(t *testing.T) {
+	loadStandards(t)
+
+	detector, err := NewDetector(DetectorOptions{})
+	assert.NoError(t, err)
+
+	versionMatcher := func(t *testing.T, standard shared.Standard, version string) *shared.ContractMatcher {
+		eips, err := GetVersions(standard)
+		assert.NoError(t, err)
+		for _, eip := range eips {
+			if eip.GetStandard().Version == version {
+				return &shared.ContractMatcher{Functions: eip.GetFunctions(), Events: eip.GetEvents(), Errors: eip.GetErrors()}
+			}
+		}
+		t.Fatalf("version %s of %s not found", version, standard)
+		return nil
+	}
+
+	ownable := standardMatcher(t, OZOWNABLE)
+	ownableV5 := &shared.ContractMatcher{Events: ownable.Events[1:], Errors: ownable.Errors}
+	for _, fn := range ownable.Functions {
+		if fn.Name != "acceptOwnership" && fn.Name != "pendingOwner" {
+			ownableV5.Functions = append(ownableV5.Functions, fn)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		contract *shared.ContractMatcher
+		standard shared.Standard
+		version  string
+		outdated bool
+	}{
+		{name: "OwnableV5", contract: ownable, standard: OZOWNABLE, version: "v5"},
+		{name: "OwnableV5WithoutTwoStep", contract: ownableV5, standard: OZOWNABLE, version: "v5"},
+		{name: "OwnableV4", contract: versionMatcher(t, OZOWNABLE, "v4"), standard: OZOWNABLE, version: "v4", outdated: true},
+		{name: "ERC721", contract: standardMatcher(t, ERC721), standard: ERC721, version: "final"},
+		{name: "ERC721Draft", contract: versionMatcher(t, ERC721, "draft"), standard: ERC721, version: "draft", outdated: true},
+		{name: "ERC20", contract: standardMatcher(t, ERC20), standard: ERC20},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			discoveries, err := detector.Detect(testCase.contract)
+			assert.NoError(t, err)
+			if !assert.NotEmpty(t, discoveries) {
+				return
+			}
+
+			discovery := discoveries[0]
+			assert.Equal(t, testCase.standard, discovery.Standard)
+			assert.Equal(t, testCase.version, discovery.Version)
+			assert.Equal(t, testCase.outdated, IsOutdated(discovery))
+		})
+	}
+}